@@ -23,7 +23,9 @@ var generateCmd = &cobra.Command{
 	Long:    `Generate a script based on a user prompt and either copy it to the clipboard or execute it directly.`,
 	Aliases: []string{"ai", "create"},
 	Run: func(cmd *cobra.Command, args []string) {
-		getAppFromContext(cmd.Context()).GenerateSnippetWithAssistant(assistantDemoScriptFlag, time.Duration(assistantDemoWaitFlag)*time.Second)
+		exitWithCode(
+			getAppFromContext(cmd.Context()).GenerateSnippetWithAssistant(assistantDemoScriptFlag, time.Duration(assistantDemoWaitFlag)*time.Second),
+		)
 	},
 }
 
@@ -3,22 +3,40 @@ package cmd
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	mocks "github.com/lemoony/snipkit/mocks/app"
 )
 
 func Test_Assistant_GenerateCmd(t *testing.T) {
-	defer resetCommand(execCmd)
+	defer resetCommand(generateCmd)
 
 	app := mocks.App{}
-	app.On("GenerateSnippetWithAssistant", mock.Anything, mock.Anything).Return(nil)
+	app.On("GenerateSnippetWithAssistant", mock.Anything, mock.Anything).Return(0)
 
 	runExecuteTest(t, []string{"assistant", "generate"}, withApp(&app))
 
 	app.AssertNumberOfCalls(t, "GenerateSnippetWithAssistant", 1)
 }
 
+func Test_Assistant_GenerateCmd_ExitCode(t *testing.T) {
+	defer resetCommand(generateCmd)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("GenerateSnippetWithAssistant", mock.Anything, mock.Anything).Return(3)
+
+	runExecuteTest(t, []string{"assistant", "generate"}, withApp(&app))
+
+	assert.Equal(t, 3, exitCode)
+}
+
 func Test_Assistant_Choose(t *testing.T) {
 	defer resetCommand(execCmd)
 
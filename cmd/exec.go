@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
+	"emperror.dev/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/lemoony/snipkit/internal/app"
 	"github.com/lemoony/snipkit/internal/model"
 )
 
@@ -13,6 +18,8 @@ var (
 	execCmdConfirmFlag    = false
 	execCmdIDFlag         string
 	execCmdParametersFlag []string
+	execCmdPresetFlag     string
+	execCmdSavePresetFlag string
 
 	parameterValueRegex = regexp.MustCompile(`^(?P<key>[a-zA-Z_][a-zA-Z0-9_]*)=(?P<value>.*)$`)
 )
@@ -20,18 +27,83 @@ var (
 var execCmd = &cobra.Command{
 	Use:   "exec",
 	Short: "Execute a snippet directly from the terminal",
-	Long:  `Execute a snippet directly from the terminal. The output of the commands will be visibile in the terminal.`,
+	Long: fmt.Sprintf(`Execute a snippet directly from the terminal. The output of the commands will be visibile in the terminal.
+
+The exit status of snipkit is the exit status of the executed snippet. If the execution is cancelled, snipkit exits
+with status %d. If no snippet with the given ID exists, snipkit exits with status %d. Any other error of snipkit
+results in status %d. Note that a snippet may exit with the same status codes itself, e.g., %d if a command of the
+snippet is not found or %d if the snippet is interrupted via Ctrl+C. The status alone therefore does not tell whether
+snipkit or the snippet failed.
+
+Parameter values can be saved as named preset via --save-preset and reused via --preset, e.g.:
+
+  snipkit exec --id <id> --save-preset prod
+  snipkit exec --id <id> --preset prod
+
+Values passed via --param take precedence over the values of a preset. Without --id, the flags apply to the snippet
+selected in the lookup. If presets exist for the selected snippet, the lookup offers to pick one of them.`, app.ExitCodeCancelled, app.ExitCodeNotFound, app.ExitCodeError,
+		app.ExitCodeNotFound, app.ExitCodeCancelled),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range []string{"preset", "save-preset"} {
+			if flag := cmd.Flags().Lookup(name); flag.Changed && strings.TrimSpace(flag.Value.String()) == "" {
+				return errors.Errorf("flag --%s requires a non-empty preset name", name)
+			}
+		}
+		return nil
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		app := getAppFromContext(cmd.Context())
 
-		if execCmdIDFlag == "" {
-			app.LookupAndExecuteSnippet(execCmdConfirmFlag, execCmdPrintFlag)
-		} else {
-			app.FindScriptAndExecuteWithParameters(execCmdIDFlag, toParameterValues(execCmdParametersFlag), execCmdConfirmFlag, execCmdPrintFlag)
+		var exitCode int
+		switch {
+		case execCmdIDFlag != "":
+			exitCode = findScriptAndExecute(app, execCmdIDFlag)
+		case execCmdPresetFlag != "" || execCmdSavePresetFlag != "":
+			exitCode = lookupScriptAndExecute(app)
+		default:
+			exitCode = app.LookupAndExecuteSnippet(execCmdConfirmFlag, execCmdPrintFlag)
 		}
+		exitWithCode(exitCode)
 	},
 }
 
+// lookupScriptAndExecute executes the snippet selected in the lookup with the values of the preset flags.
+func lookupScriptAndExecute(a app.App) int {
+	if ok, snippet := a.LookupSnippet(); ok {
+		return findScriptAndExecute(a, snippet.GetID())
+	}
+	return app.ExitCodeCancelled
+}
+
+func findScriptAndExecute(a app.App, id string) int {
+	values := toParameterValues(execCmdParametersFlag)
+	if execCmdPresetFlag != "" {
+		values = mergeParameterValues(a.PresetParameterValues(id, execCmdPresetFlag), values)
+	}
+
+	if execCmdSavePresetFlag != "" {
+		ok, presetValues := a.SaveParameterPreset(id, execCmdSavePresetFlag, values)
+		if !ok {
+			return app.ExitCodeCancelled
+		}
+		values = presetValues
+	}
+
+	return a.FindScriptAndExecuteWithParameters(id, values, execCmdConfirmFlag, execCmdPrintFlag)
+}
+
+// mergeParameterValues returns the values of both lists. If a key is contained in both lists, the value of overrides
+// is used.
+func mergeParameterValues(values, overrides []model.ParameterValue) []model.ParameterValue {
+	result := []model.ParameterValue{}
+	for _, v := range values {
+		if !slices.ContainsFunc(overrides, func(o model.ParameterValue) bool { return o.Key == v.Key }) {
+			result = append(result, v)
+		}
+	}
+	return append(result, overrides...)
+}
+
 func toParameterValues(flagValues []string) []model.ParameterValue {
 	result := make([]model.ParameterValue, len(flagValues))
 	for i, v := range flagValues {
@@ -74,5 +146,19 @@ func init() {
 		"Parameter values to be passed to the snippet",
 	)
 
+	execCmd.PersistentFlags().StringVar(
+		&execCmdPresetFlag,
+		"preset",
+		"",
+		"name of a preset whose parameter values are passed to the snippet",
+	)
+
+	execCmd.PersistentFlags().StringVar(
+		&execCmdSavePresetFlag,
+		"save-preset",
+		"",
+		"save the parameter values entered in the form as preset with the given name",
+	)
+
 	rootCmd.AddCommand(execCmd)
 }
@@ -1,9 +1,18 @@
 package cmd
 
 import (
+	"os/exec"
+	"path/filepath"
 	"testing"
 
+	"emperror.dev/errors"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	appx "github.com/lemoony/snipkit/internal/app"
 	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
 	mocks "github.com/lemoony/snipkit/mocks/app"
 )
 
@@ -11,7 +20,7 @@ func Test_Exec(t *testing.T) {
 	defer resetCommand(execCmd)
 
 	app := mocks.App{}
-	app.On("LookupAndExecuteSnippet", false, false).Return(nil)
+	app.On("LookupAndExecuteSnippet", false, false).Return(0)
 
 	runExecuteTest(t, []string{"exec"}, withApp(&app))
 
@@ -29,9 +38,241 @@ func Test_Exec_WithFlags(t *testing.T) {
 		[]model.ParameterValue{{Key: "KEY1", Value: "VALUE1"}, {Key: "KEY2", Value: "VALUE2"}},
 		false,
 		false,
-	).Return(nil)
+	).Return(0)
 
 	runExecuteTest(t, []string{"exec", "--id", "foo", "--param", "KEY1=VALUE1", "--param=KEY2=VALUE2"}, withApp(&app))
 
 	app.AssertNumberOfCalls(t, "FindScriptAndExecuteWithParameters", 1)
 }
+
+func Test_Exec_ExitCode(t *testing.T) {
+	defer resetCommand(execCmd)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("FindScriptAndExecuteWithParameters", "foo", mock.Anything, false, false).Return(3)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo"}, withApp(&app))
+
+	assert.Equal(t, 3, exitCode)
+}
+
+func Test_Exec_NotFound(t *testing.T) {
+	defer resetCommand(execCmd)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("FindScriptAndExecuteWithParameters", "foo", mock.Anything, false, false).Run(func(args mock.Arguments) {
+		panic(appx.ErrSnippetIDNotFound)
+	}).Return(0)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo"}, withApp(&app))
+
+	assert.Equal(t, appx.ExitCodeNotFound, exitCode)
+}
+
+func Test_Exec_FailedStart(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	resetExecFlags(t)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("FindScriptAndExecuteWithParameters", "foo", mock.Anything, false, false).Run(func(args mock.Arguments) {
+		err := exec.Command(filepath.Join(t.TempDir(), "python3"), "-c", "print(1)").Start()
+		panic(errors.Wrapf(errors.WithStack(err), "failed to run command"))
+	}).Return(0)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo"}, withApp(&app))
+
+	assert.Equal(t, appx.ExitCodeError, exitCode)
+}
+
+func Test_Exec_InvalidParameter(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	resetExecFlags(t)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+
+	// panics with a string instead of an error
+	runExecuteTest(t, []string{"exec", "--id", "foo", "--param", "invalid"}, withApp(&app))
+
+	assert.Equal(t, appx.ExitCodeError, exitCode)
+	app.AssertNotCalled(t, "FindScriptAndExecuteWithParameters", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Exec_WithPreset(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	resetExecFlags(t)
+
+	app := mocks.App{}
+	app.On("PresetParameterValues", "foo", "prod").
+		Return([]model.ParameterValue{{Key: "KEY1", Value: "PROD1"}, {Key: "KEY2", Value: "PROD2"}})
+	app.On("FindScriptAndExecuteWithParameters", "foo", mock.Anything, false, false).Return(0)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo", "--preset", "prod", "--param", "KEY2=VALUE2"}, withApp(&app))
+
+	app.AssertCalled(t, "FindScriptAndExecuteWithParameters", "foo",
+		[]model.ParameterValue{{Key: "KEY1", Value: "PROD1"}, {Key: "KEY2", Value: "VALUE2"}}, false, false)
+}
+
+func Test_Exec_UnknownPreset(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	resetExecFlags(t)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	exitCode := 0
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("PresetParameterValues", "foo", "unknown").Run(func(args mock.Arguments) {
+		panic(appx.ErrPresetNotFound)
+	}).Return(nil)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo", "--preset", "unknown"}, withApp(&app))
+
+	assert.Equal(t, appx.ExitCodeError, exitCode)
+	app.AssertNotCalled(t, "FindScriptAndExecuteWithParameters", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Exec_SavePreset(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	saved := []model.ParameterValue{{Key: "KEY1", Value: "PROD1"}}
+
+	app := mocks.App{}
+	app.On("SaveParameterPreset", "foo", "prod", mock.Anything).Return(true, saved)
+	app.On("FindScriptAndExecuteWithParameters", "foo", saved, false, false).Return(0)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo", "--save-preset", "prod"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "SaveParameterPreset", 1)
+	app.AssertCalled(t, "FindScriptAndExecuteWithParameters", "foo", saved, false, false)
+}
+
+func Test_Exec_SavePresetCancelled(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("SaveParameterPreset", "foo", "prod", mock.Anything).Return(false, nil)
+
+	runExecuteTest(t, []string{"exec", "--id", "foo", "--save-preset", "prod"}, withApp(&app))
+
+	assert.Equal(t, appx.ExitCodeCancelled, exitCode)
+	app.AssertNotCalled(t, "FindScriptAndExecuteWithParameters", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Exec_PresetWithoutID(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	resetExecFlags(t)
+
+	values := []model.ParameterValue{{Key: "KEY1", Value: "PROD1"}}
+
+	app := mocks.App{}
+	app.On("LookupSnippet").Return(true, testutil.TestSnippet{ID: "foo"})
+	app.On("PresetParameterValues", "foo", "prod").Return(values)
+	app.On("FindScriptAndExecuteWithParameters", "foo", values, false, false).Return(0)
+
+	runExecuteTest(t, []string{"exec", "--preset", "prod"}, withApp(&app))
+
+	app.AssertCalled(t, "FindScriptAndExecuteWithParameters", "foo", values, false, false)
+	app.AssertNotCalled(t, "LookupAndExecuteSnippet", mock.Anything, mock.Anything)
+}
+
+func Test_Exec_SavePresetWithoutID_LookupCancelled(t *testing.T) {
+	defer resetCommand(execCmd)
+	defer resetExecFlags(t)
+
+	resetExecFlags(t)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("LookupSnippet").Return(false, nil)
+
+	runExecuteTest(t, []string{"exec", "--save-preset", "prod"}, withApp(&app))
+
+	assert.Equal(t, appx.ExitCodeCancelled, exitCode)
+	app.AssertNotCalled(t, "SaveParameterPreset", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Exec_EmptyPresetName(t *testing.T) {
+	for _, name := range []string{"preset", "save-preset"} {
+		t.Run(name, func(t *testing.T) {
+			defer resetExecFlags(t)
+
+			assert.NoError(t, execCmd.Flags().Set(name, " "))
+			assert.EqualError(t, execCmd.PreRunE(execCmd, nil), "flag --"+name+" requires a non-empty preset name")
+		})
+	}
+}
+
+// resetExecFlags resets the flags --id, --param, --preset and --save-preset since cobra keeps the flag values of
+// previous tests. Values of --param would be appended otherwise.
+func resetExecFlags(t *testing.T) {
+	t.Helper()
+
+	paramFlag := execCmd.PersistentFlags().Lookup("param")
+	assert.NoError(t, paramFlag.Value.(pflag.SliceValue).Replace([]string{}))
+	paramFlag.Changed = false
+
+	for _, name := range []string{"id", "preset", "save-preset"} {
+		flag := execCmd.Flags().Lookup(name)
+		assert.NoError(t, flag.Value.Set(""))
+		flag.Changed = false
+	}
+}
+
+func Test_mergeParameterValues(t *testing.T) {
+	assert.Equal(t,
+		[]model.ParameterValue{{Key: "B", Value: "2"}, {Key: "A", Value: "override"}},
+		mergeParameterValues(
+			[]model.ParameterValue{{Key: "A", Value: "1"}, {Key: "B", Value: "2"}},
+			[]model.ParameterValue{{Key: "A", Value: "override"}},
+		),
+	)
+}
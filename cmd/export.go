@@ -22,6 +22,7 @@ var (
 		"title":      app.ExportFieldTitle,
 		"content":    app.ExportFieldContent,
 		"parameters": app.ExportFieldParameters,
+		"metadata":   app.ExportFieldMetadata,
 	}
 )
 
@@ -62,7 +63,7 @@ func init() {
 		"fields",
 		"f",
 		[]string{"id", "title", "content", "parameters"},
-		"Fields to be exported",
+		"Fields to be exported. One of: id,title,content,parameters,metadata",
 	)
 
 	exportCmd.PersistentFlags().StringVarP(
@@ -22,6 +22,12 @@ import (
 	mocks "github.com/lemoony/snipkit/mocks/managers"
 )
 
+// TestMain prevents commands from terminating the test binary. Tests which check the exit status override exitFunc.
+func TestMain(m *testing.M) {
+	exitFunc = func(int) {}
+	os.Exit(m.Run())
+}
+
 type testSetup struct {
 	app           app.App
 	configService config.Service
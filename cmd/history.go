@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var historyJSONFlag bool
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Browse the history of executed snippets",
+	Long: `Browse the history of executed snippets, the most recent one first. The preview shows the parameter values, the
+working directory, the duration and the exit code of each execution. The values of password parameters are not recorded.
+The selected execution is repeated with the same parameter values. The exit status of snipkit is then the exit status
+of the snippet.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app := getAppFromContext(cmd.Context())
+		if historyJSONFlag {
+			fmt.Println(app.ExportHistory())
+		} else {
+			exitWithCode(app.BrowseHistory())
+		}
+	},
+}
+
+func init() {
+	historyCmd.PersistentFlags().BoolVar(
+		&historyJSONFlag,
+		"json",
+		false,
+		"prints the history as JSON on stdout instead of browsing it",
+	)
+
+	rootCmd.AddCommand(historyCmd)
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocks "github.com/lemoony/snipkit/mocks/app"
+)
+
+func Test_History(t *testing.T) {
+	defer resetCommand(historyCmd)
+
+	prevExitFunc := exitFunc
+	defer func() { exitFunc = prevExitFunc }()
+
+	var exitCode int
+	exitFunc = func(code int) { exitCode = code }
+
+	app := mocks.App{}
+	app.On("BrowseHistory").Return(2)
+
+	runExecuteTest(t, []string{"history"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "BrowseHistory", 1)
+	assert.Equal(t, 2, exitCode)
+	app.AssertNotCalled(t, "ExportHistory")
+}
+
+func Test_History_JSON(t *testing.T) {
+	defer resetCommand(historyCmd)
+	defer func() { historyJSONFlag = false }()
+
+	app := mocks.App{}
+	app.On("ExportHistory").Return(`{"executions": []}`)
+
+	runExecuteTest(t, []string{"history", "--json"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "ExportHistory", 1)
+	app.AssertNotCalled(t, "BrowseHistory")
+}
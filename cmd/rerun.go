@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	rerunLastFlag bool
+	rerunIDFlag   string
+)
+
+var rerunCmd = &cobra.Command{
+	Use:   "rerun",
+	Short: "Execute a snippet of the history again with the same parameter values",
+	Long: `Execute a snippet of the history again with the same parameter values. By default, the execution is picked from
+the history. Values of password parameters have to be entered again since they are not recorded.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		exitWithCode(getAppFromContext(cmd.Context()).RerunSnippet(rerunLastFlag, rerunIDFlag))
+	},
+}
+
+func init() {
+	rerunCmd.PersistentFlags().BoolVar(
+		&rerunLastFlag,
+		"last",
+		false,
+		"re-runs the most recent execution",
+	)
+
+	rerunCmd.PersistentFlags().StringVar(
+		&rerunIDFlag,
+		"id",
+		"",
+		"re-runs the most recent execution of the snippet with the given ID",
+	)
+
+	rerunCmd.MarkFlagsMutuallyExclusive("last", "id")
+
+	rootCmd.AddCommand(rerunCmd)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	appx "github.com/lemoony/snipkit/internal/app"
+	mocks "github.com/lemoony/snipkit/mocks/app"
+)
+
+func Test_Rerun(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		last      bool
+		snippetID string
+	}{
+		{name: "pick", args: []string{"rerun"}},
+		{name: "last", args: []string{"rerun", "--last"}, last: true},
+		{name: "id", args: []string{"rerun", "--id", "foo"}, snippetID: "foo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer resetCommand(rerunCmd)
+			defer func() {
+				rerunLastFlag = false
+				rerunIDFlag = ""
+				rerunCmd.PersistentFlags().Lookup("last").Changed = false
+				rerunCmd.PersistentFlags().Lookup("id").Changed = false
+			}()
+
+			prevExitFunc := exitFunc
+			defer func() { exitFunc = prevExitFunc }()
+
+			var exitCode int
+			exitFunc = func(code int) { exitCode = code }
+
+			app := mocks.App{}
+			app.On("RerunSnippet", tt.last, tt.snippetID).Return(2)
+
+			runExecuteTest(t, tt.args, withApp(&app))
+
+			app.AssertCalled(t, "RerunSnippet", tt.last, tt.snippetID)
+			assert.Equal(t, 2, exitCode)
+		})
+	}
+}
+
+func Test_Rerun_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		err  error
+	}{
+		{name: "empty history", args: []string{"rerun", "--last"}, err: appx.ErrNoHistory},
+		{name: "unknown id", args: []string{"rerun", "--id", "unknown"}, err: appx.ErrSnippetNotInHistory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer resetCommand(rerunCmd)
+			defer func() {
+				rerunLastFlag = false
+				rerunIDFlag = ""
+				rerunCmd.PersistentFlags().Lookup("last").Changed = false
+				rerunCmd.PersistentFlags().Lookup("id").Changed = false
+			}()
+
+			prevExitFunc := exitFunc
+			defer func() { exitFunc = prevExitFunc }()
+
+			var exitCode int
+			exitFunc = func(code int) { exitCode = code }
+
+			app := mocks.App{}
+			app.On("RerunSnippet", mock.Anything, mock.Anything).Run(func(mock.Arguments) { panic(tt.err) })
+
+			runExecuteTest(t, tt.args, withApp(&app))
+
+			assert.Equal(t, appx.ExitCodeError, exitCode)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"emperror.dev/errors"
 	"github.com/phuslu/log"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -108,6 +109,9 @@ func getConfigServiceFromContext(ctx context.Context) config.Service {
 var (
 	cfgFile  string
 	logLevel string
+
+	// exitFunc terminates the process with the given status. It can be overridden in tests.
+	exitFunc = os.Exit
 )
 
 var rootCmd = &cobra.Command{
@@ -165,9 +169,21 @@ func handlePanic() {
 
 		if e, ok := err.(error); ok {
 			log.Error().Err(e).Stack().Msgf("Exited with panic error: %s", e)
+			if errors.Is(e, app.ErrSnippetIDNotFound) {
+				exitFunc(app.ExitCodeNotFound)
+				return
+			}
 		} else {
 			log.Error().Msgf("Exited with panic: %s", err)
 		}
+		exitFunc(app.ExitCodeError)
+	}
+}
+
+// exitWithCode terminates the process with the given status unless it is zero.
+func exitWithCode(code int) {
+	if code != 0 {
+		exitFunc(code)
 	}
 }
 
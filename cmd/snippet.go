@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"emperror.dev/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+var (
+	snippetNewTitleFlag    string
+	snippetNewTagsFlag     []string
+	snippetNewManagerFlag  string
+	snippetNewLanguageFlag string
+	snippetNewFileFlag     string
+
+	snippetIDFlag    string
+	snippetRmYesFlag = false
+	snippetLanguages = map[string]model.Language{
+		"bash":     model.LanguageBash,
+		"yaml":     model.LanguageYAML,
+		"markdown": model.LanguageMarkdown,
+		"toml":     model.LanguageTOML,
+		"text":     model.LanguageText,
+
+		"python":     model.LanguagePython,
+		"ruby":       model.LanguageRuby,
+		"javascript": model.LanguageJavaScript,
+		"powershell": model.LanguagePowerShell,
+		"perl":       model.LanguagePerl,
+		"sql":        model.LanguageSQL,
+		"dockerfile": model.LanguageDockerfile,
+		"json":       model.LanguageJSON,
+	}
+)
+
+var snippetCmd = &cobra.Command{
+	Use:   "snippet",
+	Short: "Create, modify and inspect snippets",
+	Long: `Create and modify snippets of the snippet managers that support it (file system library, pet, massCode v2 and
+GitHub Gist). The metadata of the snippets of all managers can be shown via 'snippet info'.`,
+}
+
+var snippetNewCmd = &cobra.Command{
+	Use:   "new",
+	Short: "Create a new snippet",
+	Long: `Create a new snippet. The content is read from the file specified via --file (use - for stdin). If no file is
+specified, your editor is opened.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		language, ok := snippetLanguages[snippetNewLanguageFlag]
+		if !ok {
+			panic("Unsupported language: " + snippetNewLanguageFlag)
+		}
+
+		getAppFromContext(cmd.Context()).CreateSnippet(model.ManagerKey(snippetNewManagerFlag), model.SnippetDraft{
+			Title:    snippetNewTitleFlag,
+			Content:  readSnippetContent(cmd, snippetNewFileFlag),
+			Tags:     snippetNewTagsFlag,
+			Language: language,
+		})
+	},
+}
+
+var snippetEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit the content of a snippet in your editor",
+	Run: func(cmd *cobra.Command, args []string) {
+		getAppFromContext(cmd.Context()).EditSnippet(snippetIDFlag)
+	},
+}
+
+var snippetRmCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Delete a snippet",
+	Run: func(cmd *cobra.Command, args []string) {
+		getAppFromContext(cmd.Context()).DeleteSnippet(snippetIDFlag, snippetRmYesFlag)
+	},
+}
+
+var snippetMvCmd = &cobra.Command{
+	Use:   "mv <new title>",
+	Short: "Rename a snippet",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		getAppFromContext(cmd.Context()).RenameSnippet(snippetIDFlag, args[0])
+	},
+}
+
+var snippetInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show the metadata of a snippet, e.g., its manager and where it is stored",
+	Run: func(cmd *cobra.Command, args []string) {
+		getAppFromContext(cmd.Context()).SnippetInfo(snippetIDFlag)
+	},
+}
+
+func readSnippetContent(cmd *cobra.Command, file string) string {
+	var contents []byte
+	var err error
+
+	switch file {
+	case "":
+		return ""
+	case "-":
+		contents, err = io.ReadAll(cmd.InOrStdin())
+	default:
+		contents, err = os.ReadFile(file)
+	}
+
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to read snippet content from %s", file))
+	}
+	return string(contents)
+}
+
+func init() {
+	snippetNewCmd.PersistentFlags().StringVarP(&snippetNewTitleFlag, "title", "t", "", "Title of the snippet")
+	_ = snippetNewCmd.MarkPersistentFlagRequired("title")
+	snippetNewCmd.PersistentFlags().StringArrayVar(&snippetNewTagsFlag, "tag", []string{}, "Tag of the snippet (can be repeated)")
+	snippetNewCmd.PersistentFlags().StringVarP(
+		&snippetNewManagerFlag, "manager", "m", "", "Key of the manager to store the snippet (e.g. fslibrary, pet, massCode)",
+	)
+	snippetNewCmd.PersistentFlags().StringVar(
+		&snippetNewLanguageFlag, "language", "bash", "Language of the snippet. One of: bash,yaml,markdown,toml,text,python,ruby,javascript,powershell,perl,sql,dockerfile,json",
+	)
+	snippetNewCmd.PersistentFlags().StringVarP(
+		&snippetNewFileFlag, "file", "f", "", "File to read the content from (use - for stdin)",
+	)
+
+	for _, c := range []*cobra.Command{snippetEditCmd, snippetRmCmd, snippetMvCmd, snippetInfoCmd} {
+		c.PersistentFlags().StringVar(&snippetIDFlag, "id", "", "ID of the snippet (if not set, the snippet is looked up)")
+	}
+	snippetRmCmd.PersistentFlags().BoolVarP(&snippetRmYesFlag, "yes", "y", false, "Delete the snippet without confirmation")
+
+	snippetCmd.AddCommand(snippetNewCmd)
+	snippetCmd.AddCommand(snippetEditCmd)
+	snippetCmd.AddCommand(snippetRmCmd)
+	snippetCmd.AddCommand(snippetMvCmd)
+	snippetCmd.AddCommand(snippetInfoCmd)
+	rootCmd.AddCommand(snippetCmd)
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	mocks "github.com/lemoony/snipkit/mocks/app"
+)
+
+func Test_SnippetNew(t *testing.T) {
+	defer resetCommand(snippetNewCmd)
+
+	contentFile := filepath.Join(t.TempDir(), "content.sh")
+	assert.NoError(t, os.WriteFile(contentFile, []byte("echo foo"), 0o600))
+
+	app := mocks.App{}
+	app.On("CreateSnippet", model.ManagerKey("pet"), model.SnippetDraft{
+		Title:    "Foo",
+		Content:  "echo foo",
+		Tags:     []string{"a", "b"},
+		Language: model.LanguageBash,
+	}).Return()
+
+	runExecuteTest(
+		t,
+		[]string{"snippet", "new", "--title", "Foo", "--tag", "a", "--tag", "b", "--manager", "pet", "--file", contentFile},
+		withApp(&app),
+	)
+
+	app.AssertNumberOfCalls(t, "CreateSnippet", 1)
+}
+
+func Test_SnippetEdit(t *testing.T) {
+	defer resetCommand(snippetEditCmd)
+
+	app := mocks.App{}
+	app.On("EditSnippet", "foo-id").Return()
+
+	runExecuteTest(t, []string{"snippet", "edit", "--id", "foo-id"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "EditSnippet", 1)
+}
+
+func Test_SnippetRm(t *testing.T) {
+	defer resetCommand(snippetRmCmd)
+
+	app := mocks.App{}
+	app.On("DeleteSnippet", "foo-id", true).Return()
+
+	runExecuteTest(t, []string{"snippet", "rm", "--id", "foo-id", "--yes"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "DeleteSnippet", 1)
+}
+
+func Test_SnippetInfo(t *testing.T) {
+	defer resetCommand(snippetInfoCmd)
+
+	app := mocks.App{}
+	app.On("SnippetInfo", "foo-id").Return()
+
+	runExecuteTest(t, []string{"snippet", "info", "--id", "foo-id"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "SnippetInfo", 1)
+}
+
+func Test_SnippetMv(t *testing.T) {
+	defer resetCommand(snippetMvCmd)
+
+	app := mocks.App{}
+	app.On("RenameSnippet", "foo-id", "New title").Return()
+
+	runExecuteTest(t, []string{"snippet", "mv", "--id", "foo-id", "New title"}, withApp(&app))
+
+	app.AssertNumberOfCalls(t, "RenameSnippet", 1)
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	gosync "sync"
 	"time"
 
 	"emperror.dev/errors"
@@ -17,6 +18,8 @@ import (
 	"github.com/lemoony/snipkit/internal/utils/system"
 )
 
+const defaultManagerTimeout = 10 * time.Second
+
 var ErrNoSnippetsAvailable = errors.New("No snippets are available.")
 
 var ErrSnippetIDNotFound = errors.New("Snippet with ID not found.")
@@ -40,11 +43,21 @@ type App interface {
 	LookupAndCreatePrintableSnippet() (bool, string)
 	LookupSnippetArgs() (bool, string, []model.ParameterValue)
 	FindSnippetAndPrint(string, []model.ParameterValue) (bool, string)
-	LookupAndExecuteSnippet(bool, bool)
-	FindScriptAndExecuteWithParameters(string, []model.ParameterValue, bool, bool)
+	LookupAndExecuteSnippet(bool, bool) int
+	FindScriptAndExecuteWithParameters(string, []model.ParameterValue, bool, bool) int
+	PresetParameterValues(string, string) []model.ParameterValue
+	SaveParameterPreset(string, string, []model.ParameterValue) (bool, []model.ParameterValue)
 	ExportSnippets([]ExportField, ExportFormat) string
-	GenerateSnippetWithAssistant([]string, time.Duration)
+	GenerateSnippetWithAssistant([]string, time.Duration) int
 	EnableAssistant()
+	CreateSnippet(model.ManagerKey, model.SnippetDraft)
+	EditSnippet(string)
+	DeleteSnippet(string, bool)
+	RenameSnippet(string, string)
+	SnippetInfo(string)
+	BrowseHistory() int
+	ExportHistory() string
+	RerunSnippet(bool, string) int
 	Info()
 	AddManager()
 	SyncManager()
@@ -163,11 +176,80 @@ type appImpl struct {
 	checkNeedsConfigMigration bool
 }
 
+// managerSnippets holds the result of loading the snippets of a single manager.
+type managerSnippets struct {
+	prioritized []model.Snippet
+	snippets    []model.Snippet
+	err         error
+}
+
+// getAllSnippets loads the snippets of all managers concurrently. If a manager fails or exceeds the manager timeout,
+// a warning is printed and the snippets of all other managers are still returned.
 func (a *appImpl) getAllSnippets() []model.Snippet {
+	results := make([]managerSnippets, len(a.managers))
+
+	var wg gosync.WaitGroup
+	for i, manager := range a.managers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = a.loadSnippets(manager)
+		}()
+	}
+	wg.Wait()
+
+	var prioritized []model.Snippet
 	var result []model.Snippet
-	for _, manager := range a.managers {
-		result = append(result, manager.GetSnippets()...)
+	for i, r := range results {
+		if r.err != nil {
+			log.Warn().Err(r.err).Str("manager", string(a.managers[i].Key())).Msg("Failed to load snippets")
+			a.tui.Print(uimsg.ManagerLoadFailed(string(a.managers[i].Key()), r.err.Error()))
+			continue
+		}
+		prioritized = append(prioritized, r.prioritized...)
+		result = append(result, r.snippets...)
 	}
-	log.Trace().Msgf("Number of available snippets: %d", len(result))
+
+	result = append(prioritized, result...)
+	log.Trace().Msgf("Number of available snippets: %d (prioritized: %d)", len(result), len(prioritized))
 	return result
 }
+
+func (a *appImpl) loadSnippets(manager managers.Manager) managerSnippets {
+	done := make(chan managerSnippets, 1)
+
+	go func() {
+		defer func() {
+			if panicValue := recover(); panicValue != nil {
+				if err, ok := panicValue.(error); ok {
+					done <- managerSnippets{err: err}
+				} else {
+					done <- managerSnippets{err: errors.Errorf("%v", panicValue)}
+				}
+			}
+		}()
+
+		var result managerSnippets
+		prioritizer, canPrioritize := manager.(managers.SnippetPrioritizer)
+		for _, snippet := range manager.GetSnippets() {
+			if canPrioritize && prioritizer.IsPrioritized(snippet) {
+				result.prioritized = append(result.prioritized, snippet)
+			} else {
+				result.snippets = append(result.snippets, snippet)
+			}
+		}
+		done <- result
+	}()
+
+	timeout := defaultManagerTimeout
+	if a.config != nil && a.config.ManagerTimeout > 0 {
+		timeout = a.config.ManagerTimeout
+	}
+
+	select {
+	case result := <-done:
+		return result
+	case <-time.After(timeout):
+		return managerSnippets{err: errors.Errorf("loading snippets took longer than %s", timeout)}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/lemoony/snipkit/internal/config"
 	"github.com/lemoony/snipkit/internal/managers"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
+	"github.com/lemoony/snipkit/internal/managers/githubgist"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/ui/assistant/chat"
 	"github.com/lemoony/snipkit/internal/ui/picker"
@@ -20,7 +21,9 @@ import (
 	"github.com/lemoony/snipkit/internal/utils/tmpdir"
 )
 
-func (a *appImpl) GenerateSnippetWithAssistant(demoScriptPath []string, demoQueryDuration time.Duration) {
+// GenerateSnippetWithAssistant returns the exit code of the last executed script if the user quit the assistant after
+// its execution. Otherwise, 0 is returned.
+func (a *appImpl) GenerateSnippetWithAssistant(demoScriptPath []string, demoQueryDuration time.Duration) int {
 	asst := a.assistantProviderFunc(
 		a.config.Assistant,
 		assistant.DemoConfig{ScriptPaths: demoScriptPath, QueryDuration: demoQueryDuration},
@@ -32,14 +35,21 @@ func (a *appImpl) GenerateSnippetWithAssistant(demoScriptPath []string, demoQuer
 
 	// Start unified assistant loop with empty history
 	history := []chat.HistoryEntry{}
-	a.unifiedAssistantLoop(history, asst)
+	return a.unifiedAssistantLoop(history, asst)
 }
 
 func (a *appImpl) saveScript(contents []byte, title, filename string) {
+	managerKey := fslibrary.Key
+	if a.config.Assistant.SaveMode == assistant.SaveModeGitHubGist {
+		managerKey = githubgist.Key
+	}
+
 	if manager, ok := sliceutil.FindElement(a.managers, func(manager managers.Manager) bool {
-		return manager.Key() == fslibrary.Key
+		return manager.Key() == managerKey
 	}); ok {
 		manager.SaveAssistantSnippet(title, filename, contents)
+	} else if managerKey == githubgist.Key {
+		panic("GitHub Gist not configured as manager. Try running `snipkit manager add`")
 	} else {
 		panic("File system library not configured as manager. Try running `snipkit manager add`")
 	}
@@ -86,11 +96,12 @@ func (a *appImpl) handleReviseAction(history []chat.HistoryEntry, script assista
 	return false, history
 }
 
-// handleExecuteAction handles the execute action and returns updated history.
-func (a *appImpl) handleExecuteAction(history []chat.HistoryEntry, script assistant.ParsedScript, paramValues []string) []chat.HistoryEntry {
+// handleExecuteAction handles the execute action. Returns the output of the execution (nil if nothing was executed)
+// and the updated history.
+func (a *appImpl) handleExecuteAction(history []chat.HistoryEntry, script assistant.ParsedScript, paramValues []string) (*capturedOutput, []chat.HistoryEntry) {
 	if script.Contents == "" {
 		log.Error().Msg("Execute action but no script available")
-		return a.addExecutionError(history, "Error: No script available to execute")
+		return nil, a.addExecutionError(history, "Error: No script available to execute")
 	}
 
 	// Prepare snippet and check for parameters
@@ -107,7 +118,7 @@ func (a *appImpl) handleExecuteAction(history []chat.HistoryEntry, script assist
 	executionTime := time.Now()
 	log.Trace().Msg("Snippet execution completed, about to return to chat")
 
-	return a.updateHistoryWithSuccess(history, script, capturedResult, executionTime)
+	return capturedResult, a.updateHistoryWithSuccess(history, script, capturedResult, executionTime)
 }
 
 // handleEditAction handles the edit action. Returns (shouldContinue, updatedHistory).
@@ -184,8 +195,9 @@ func (a *appImpl) updateHistoryWithSuccess(history []chat.HistoryEntry, parsed a
 	return history
 }
 
-// unifiedAssistantLoop manages the unified chat interaction loop.
-func (a *appImpl) unifiedAssistantLoop(history []chat.HistoryEntry, asst assistant.Assistant) {
+// unifiedAssistantLoop manages the unified chat interaction loop. Returns the exit code of the last executed script
+// if the user quit after its execution, otherwise 0.
+func (a *appImpl) unifiedAssistantLoop(history []chat.HistoryEntry, asst assistant.Assistant) int {
 	tmpDirSvc := tmpdir.New(a.system)
 	defer tmpDirSvc.ClearFiles()
 
@@ -200,21 +212,25 @@ func (a *appImpl) unifiedAssistantLoop(history []chat.HistoryEntry, asst assista
 		switch action {
 		case chat.PreviewActionCancel:
 			a.handleCancelAction(scriptInterface, saveFilename, saveSnippetName)
-			return
+			return 0
 
 		case chat.PreviewActionExitNoSave:
-			return
+			return 0
 
 		case chat.PreviewActionRevise:
 			if shouldReturn, updatedHistory := a.handleReviseAction(history, scriptInterface, latestPrompt); shouldReturn {
-				return
+				return 0
 			} else {
 				history = updatedHistory
 				continue
 			}
 
 		case chat.PreviewActionExecute:
-			history = a.handleExecuteAction(history, scriptInterface, paramValues)
+			var output *capturedOutput
+			output, history = a.handleExecuteAction(history, scriptInterface, paramValues)
+			if output != nil && output.quit {
+				return output.processExitCode()
+			}
 			continue
 
 		case chat.PreviewActionEdit:
@@ -222,7 +238,7 @@ func (a *appImpl) unifiedAssistantLoop(history []chat.HistoryEntry, asst assista
 				history = updatedHistory
 				continue
 			}
-			return
+			return 0
 		}
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/lemoony/snipkit/internal/config/configtest"
 	"github.com/lemoony/snipkit/internal/managers"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
+	"github.com/lemoony/snipkit/internal/managers/githubgist"
 	"github.com/lemoony/snipkit/internal/ui/assistant/chat"
 	"github.com/lemoony/snipkit/internal/ui/picker"
 	"github.com/lemoony/snipkit/internal/ui/uimsg"
@@ -353,3 +354,26 @@ func Test_App_EnableAssistant(t *testing.T) {
 	tui.AssertCalled(t, mockutil.Confirmation, mock.AnythingOfType("uimsg.Confirm"))
 	tui.AssertCalled(t, mockutil.Print, uimsg.AssistantUpdateConfigResult(true, "/foo/path"))
 }
+
+func Test_saveScript_GitHubGist(t *testing.T) {
+	cfg := configtest.NewTestConfig().Config
+	cfg.Assistant.SaveMode = assistant.SaveModeGitHubGist
+
+	fsLibManager := managerMocks.Manager{}
+	fsLibManager.On("Key").Return(fslibrary.Key)
+
+	gistManager := managerMocks.Manager{}
+	gistManager.On("Key").Return(githubgist.Key)
+	gistManager.On(mockutil.SaveAssistantSnippet, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	app := &appImpl{config: &cfg, managers: []managers.Manager{&fsLibManager, &gistManager}}
+	app.saveScript([]byte("echo foo"), "Echo foo", "echo-foo.sh")
+
+	gistManager.AssertCalled(t, mockutil.SaveAssistantSnippet, "Echo foo", "echo-foo.sh", []byte("echo foo"))
+	fsLibManager.AssertNotCalled(t, mockutil.SaveAssistantSnippet, mock.Anything, mock.Anything, mock.Anything)
+
+	app.managers = []managers.Manager{&fsLibManager}
+	assert.Panics(t, func() {
+		app.saveScript([]byte("echo foo"), "Echo foo", "echo-foo.sh")
+	})
+}
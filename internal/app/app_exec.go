@@ -17,11 +17,31 @@ import (
 	"github.com/lemoony/snipkit/internal/ui"
 	"github.com/lemoony/snipkit/internal/ui/execution"
 	"github.com/lemoony/snipkit/internal/ui/uimsg"
+	"github.com/lemoony/snipkit/internal/utils/langdetect"
 	"github.com/lemoony/snipkit/internal/utils/stringutil"
 )
 
 const fallbackShell = "/bin/bash"
 
+const (
+	// ExitCodeNotFound is the exit status of snipkit if the snippet to execute does not exist.
+	ExitCodeNotFound = 127
+	// ExitCodeCancelled is the exit status of snipkit if the execution of a snippet was cancelled by the user.
+	ExitCodeCancelled = 130
+	// ExitCodeError is the exit status of snipkit if an error occurred, e.g., the interpreter could not be started.
+	ExitCodeError = 1
+)
+
+// defaultInterpreters holds the commands to execute scripts which are not shell scripts. The script is passed as last
+// argument.
+var defaultInterpreters = map[model.Language]string{
+	model.LanguagePython:     "python3 -c",
+	model.LanguageRuby:       "ruby -e",
+	model.LanguageJavaScript: "node -e",
+	model.LanguagePowerShell: "pwsh -NoProfile -Command",
+	model.LanguagePerl:       "perl -e",
+}
+
 // ExecutionContext indicates the origin of the execution request.
 type ExecutionContext int
 
@@ -38,6 +58,21 @@ type capturedOutput struct {
 	exitCode int
 	duration time.Duration
 	err      error
+	quit     bool
+}
+
+// processExitCode returns the exit code to be used as exit status of the snipkit process. A nil output means that the
+// execution was cancelled. If the exit code of the snippet could not be determined, the generic failure code 1 is
+// returned.
+func (o *capturedOutput) processExitCode() int {
+	switch {
+	case o == nil:
+		return ExitCodeCancelled
+	case o.exitCode < 0:
+		return ExitCodeError
+	default:
+		return o.exitCode
+	}
 }
 
 // Terminal function variables that can be overridden in tests.
@@ -48,23 +83,33 @@ var (
 	restoreTermFunc = term.Restore
 )
 
-func (a *appImpl) LookupAndExecuteSnippet(confirm, print bool) {
+// LookupAndExecuteSnippet returns the exit code of the executed snippet or ExitCodeCancelled if the user did not
+// select a snippet, cancelled the parameter form or declined the confirmation.
+func (a *appImpl) LookupAndExecuteSnippet(confirm, print bool) int {
 	if ok, snippet := a.LookupSnippet(); ok {
-		parameters := snippet.GetParameters()
-		if parameterValues, paramOk := a.tui.ShowParameterForm(parameters, nil, ui.OkButtonExecute); paramOk {
-			a.executeSnippet(ContextDefault, print, snippet, parameterValues)
+		if parameterValues, paramOk := a.showLookupParameterForm(snippet, ui.OkButtonExecute); paramOk {
+			return a.executeSnippet(ContextDefault, print, snippet, parameterValues).processExitCode()
 		}
 	}
+	return ExitCodeCancelled
 }
 
-func (a *appImpl) FindScriptAndExecuteWithParameters(id string, paramValues []model.ParameterValue, confirm, print bool) {
-	if snippetFound, snippet := a.getSnippet(id); !snippetFound {
+// FindScriptAndExecuteWithParameters returns the exit code of the executed snippet or ExitCodeCancelled if the user
+// cancelled the parameter form or declined the confirmation. It panics with ErrSnippetIDNotFound if no snippet with
+// the given ID exists.
+func (a *appImpl) FindScriptAndExecuteWithParameters(id string, paramValues []model.ParameterValue, confirm, print bool) int {
+	snippetFound, snippet := a.getSnippet(id)
+	if !snippetFound {
 		panic(ErrSnippetIDNotFound)
-	} else if paramOk, parameters := matchParameters(paramValues, snippet.GetParameters()); paramOk {
-		a.executeSnippet(ContextDefault, print, snippet, parameters)
-	} else if parameterValues, formOk := a.tui.ShowParameterForm(snippet.GetParameters(), paramValues, ui.OkButtonExecute); formOk {
-		a.executeSnippet(ContextDefault, print, snippet, parameterValues)
 	}
+
+	paramValues = a.withCommandDefaults(snippet, paramValues)
+	if paramOk, parameters := matchParameters(paramValues, snippet.GetParameters()); paramOk {
+		return a.executeSnippet(ContextDefault, print, snippet, parameters).processExitCode()
+	} else if parameterValues, formOk := a.showParameterForm(snippet, paramValues, ui.OkButtonExecute); formOk {
+		return a.executeSnippet(ContextDefault, print, snippet, parameterValues).processExitCode()
+	}
+	return ExitCodeCancelled
 }
 
 func (a *appImpl) getSnippet(id string) (bool, model.Snippet) {
@@ -92,7 +137,8 @@ func matchParameters(paramValues []model.ParameterValue, snippetParameters []mod
 }
 
 func (a *appImpl) executeSnippet(context ExecutionContext, print bool, snippet model.Snippet, parameterValues []string) *capturedOutput {
-	script := snippet.Format(parameterValues, formatOptions(a.config.Script))
+	language := snippetLanguage(snippet)
+	script := snippet.Format(parameterValues, formatOptions(a.config.Script, language))
 
 	// Skip confirmation for assistant context (parameter modal serves as implicit confirmation)
 	if context == ContextDefault && a.config.Script.ExecConfirm && !a.tui.Confirmation(uimsg.ExecConfirm(snippet.GetTitle(), script)) {
@@ -104,14 +150,19 @@ func (a *appImpl) executeSnippet(context ExecutionContext, print bool, snippet m
 		a.tui.Print(uimsg.ExecPrint(snippet.GetTitle(), script))
 	}
 
-	return executeScript(context, script, a.config.Script.Shell)
+	start := time.Now()
+	output := executeScript(context, script, language, a.config.Script)
+	if context == ContextDefault {
+		a.recordExecution(snippet, parameterValues, start, output)
+	}
+	return output
 }
 
-func executeScript(context ExecutionContext, script, configuredShell string) *capturedOutput {
-	shell := detectShell(script, configuredShell)
+func executeScript(context ExecutionContext, script string, language model.Language, cfg config.ScriptConfig) *capturedOutput {
+	command := scriptCommand(script, language, cfg)
 
 	//nolint:gosec // since it would report G204 complaining about using a variable as input for exec.Command
-	cmd := exec.Command(shell, "-c", script)
+	cmd := exec.Command(command[0], command[1:]...)
 
 	// Run the script
 	if isTerminalFunc(int(os.Stdin.Fd())) {
@@ -121,6 +172,7 @@ func executeScript(context ExecutionContext, script, configuredShell string) *ca
 			stdout:   result.Stdout,
 			exitCode: result.ExitCode,
 			duration: result.Duration,
+			quit:     result.Quit,
 		}
 	}
 
@@ -171,37 +223,71 @@ func executeWithoutPTY(cmd *exec.Cmd) *capturedOutput {
 	}
 }
 
+// scriptCommand returns the command line to execute the script with. Scripts with a shebang are executed with the
+// interpreter of the shebang, scripts of languages without interpreter with the shell, and all other scripts with the
+// interpreter of their language.
+func scriptCommand(script string, language model.Language, cfg config.ScriptConfig) []string {
+	if interpreter, ok := langdetect.ShebangInterpreter(script); ok {
+		return append([]string{interpreter}, append(inlineScriptArgs(script), script)...)
+	}
+	if interpreter, ok := interpreterForLanguage(language, cfg); ok {
+		return append(interpreter, script)
+	}
+	return []string{detectShell(script, cfg.Shell), "-c", script}
+}
+
+// inlineScriptArgs returns the arguments to pass a script inline to the interpreter of its shebang, e.g., "-e" for
+// ruby. The arguments are taken from the default interpreter of the language of the shebang. Shells and unknown
+// interpreters get "-c".
+func inlineScriptArgs(script string) []string {
+	if language, ok := langdetect.ShebangLanguage(script); ok {
+		if fields := strings.Fields(defaultInterpreters[language]); len(fields) > 1 {
+			return fields[1:]
+		}
+	}
+	return []string{"-c"}
+}
+
+// interpreterForLanguage returns the interpreter command of the language. An interpreter configured via ScriptConfig
+// takes precedence over the default one. Configuring an empty interpreter executes the scripts of the language with
+// the shell.
+func interpreterForLanguage(language model.Language, cfg config.ScriptConfig) ([]string, bool) {
+	command, ok := cfg.Interpreters[language.Name()]
+	if !ok {
+		command = defaultInterpreters[language]
+	}
+	fields := strings.Fields(command)
+	return fields, len(fields) > 0
+}
+
 // detectShell determines which shell to use for script execution.
 // Priority: shebang in script > configured shell > $SHELL env var > fallback.
 func detectShell(script, configuredShell string) string {
-	// Check for shebang in the script
-	if strings.HasPrefix(script, "#!") {
-		if idx := strings.Index(script, "\n"); idx != -1 {
-			shebang := strings.TrimSpace(script[2:idx])
-			// Handle "#!/usr/bin/env bash" style shebangs
-			if strings.HasPrefix(shebang, "/usr/bin/env ") {
-				interpreter := strings.TrimSpace(strings.TrimPrefix(shebang, "/usr/bin/env "))
-				// Remove any arguments after the interpreter name
-				if spaceIdx := strings.Index(interpreter, " "); spaceIdx != -1 {
-					interpreter = interpreter[:spaceIdx]
-				}
-				return interpreter
-			}
-			// Handle direct path shebangs like "#!/bin/bash" or "#!/bin/zsh"
-			// Remove any arguments after the path
-			if spaceIdx := strings.Index(shebang, " "); spaceIdx != -1 {
-				shebang = shebang[:spaceIdx]
-			}
-			return shebang
-		}
+	if interpreter, ok := langdetect.ShebangInterpreter(script); ok {
+		return interpreter
 	}
-
 	return stringutil.FirstNotEmpty(configuredShell, os.Getenv("SHELL"), fallbackShell)
 }
 
-func formatOptions(cfg config.ScriptConfig) model.SnippetFormatOptions {
+// snippetLanguage returns the language to execute the snippet with. For snippets without a known language, only the
+// shebang line is considered. Languages detected based on the content are not reliable enough to choose the
+// interpreter and are used for highlighting the preview only.
+func snippetLanguage(snippet model.Snippet) model.Language {
+	language := snippet.GetLanguage()
+	if language != model.LanguageUnknown && language != model.LanguageText {
+		return language
+	}
+	if shebangLanguage, ok := langdetect.ShebangLanguage(snippet.GetContent()); ok {
+		return shebangLanguage
+	}
+	return language
+}
+
+func formatOptions(cfg config.ScriptConfig, language model.Language) model.SnippetFormatOptions {
+	_, hasInterpreter := interpreterForLanguage(language, cfg)
+
 	var paramMode model.SnippetParamMode
-	if strings.EqualFold(string(config.ParameterModeReplace), string(cfg.ParameterMode)) {
+	if hasInterpreter || strings.EqualFold(string(config.ParameterModeReplace), string(cfg.ParameterMode)) {
 		paramMode = model.SnippetParamModeReplace
 	} else {
 		paramMode = model.SnippetParamModeSet
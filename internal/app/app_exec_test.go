@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -80,7 +81,62 @@ echo "${VAR1}"`
 		withManagerSnippets(snippets),
 	)
 
-	app.FindScriptAndExecuteWithParameters("uuid1", []model.ParameterValue{{Key: "VAR1", Value: "foo"}}, false, false)
+	exitCode := app.FindScriptAndExecuteWithParameters("uuid1", []model.ParameterValue{{Key: "VAR1", Value: "foo"}}, false, false)
+	assert.Equal(t, 0, exitCode)
+}
+
+func Test_App_Exec_ExitCode(t *testing.T) {
+	snippets := []model.Snippet{
+		testutil.TestSnippet{ID: "uuid1", Title: "title-1", Language: model.LanguageBash, Content: "exit 3"},
+	}
+
+	tests := []struct {
+		name         string
+		confirm      bool
+		formOk       bool
+		lookupIndex  int
+		expectedCode int
+	}{
+		{name: "exit code of snippet", confirm: true, formOk: true, lookupIndex: 0, expectedCode: 3},
+		{name: "lookup cancelled", confirm: true, formOk: true, lookupIndex: -1, expectedCode: ExitCodeCancelled},
+		{name: "parameter form cancelled", confirm: true, formOk: false, lookupIndex: 0, expectedCode: ExitCodeCancelled},
+		{name: "confirmation declined", confirm: false, formOk: true, lookupIndex: 0, expectedCode: ExitCodeCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tui := uiMocks.TUI{}
+			tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+			tui.On("ShowLookup", mock.Anything, mock.Anything).Return(tt.lookupIndex)
+			tui.On("ShowParameterForm", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, tt.formOk)
+			tui.On(mockutil.Confirmation, mock.Anything).Return(tt.confirm)
+
+			cfg := configtest.NewTestConfig().Config
+			cfg.Script.ExecConfirm = true
+			cfg.Script.Shell = "/bin/sh"
+
+			app := NewApp(WithTUI(&tui), WithConfig(cfg), withManagerSnippets(snippets))
+			assert.Equal(t, tt.expectedCode, app.LookupAndExecuteSnippet(false, false))
+		})
+	}
+}
+
+func Test_App_Exec_NotFound(t *testing.T) {
+	tui := uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+
+	app := NewApp(WithTUI(&tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets(nil))
+	assert.PanicsWithValue(t, ErrSnippetIDNotFound, func() {
+		_ = app.FindScriptAndExecuteWithParameters("unknown", nil, false, false)
+	})
+}
+
+func Test_capturedOutput_processExitCode(t *testing.T) {
+	var cancelled *capturedOutput
+	assert.Equal(t, ExitCodeCancelled, cancelled.processExitCode())
+	assert.Equal(t, 0, (&capturedOutput{exitCode: 0}).processExitCode())
+	assert.Equal(t, 2, (&capturedOutput{exitCode: 2}).processExitCode())
+	assert.Equal(t, 1, (&capturedOutput{exitCode: -1}).processExitCode())
 }
 
 func Test_App_Exec_FindScriptAndExecuteWithParameters_MissingParameters(t *testing.T) {
@@ -107,6 +163,7 @@ echo "${VAR1}"`
 		WithTUI(&tui),
 		WithConfig(configtest.NewTestConfig().Config),
 		withManagerSnippets(snippets),
+		withCache(newTestCache()),
 	)
 
 	app.FindScriptAndExecuteWithParameters("uuid1", []model.ParameterValue{}, false, false)
@@ -196,7 +253,7 @@ func Test_executeWithoutPTY(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := executeScript(ContextDefault, tt.script, "/bin/sh")
+			result := executeScript(ContextDefault, tt.script, model.LanguageBash, config.ScriptConfig{Shell: "/bin/sh"})
 			assert.Equal(t, tt.expectedStdout, result.stdout)
 			assert.Equal(t, tt.expectedStderr, result.stderr)
 		})
@@ -206,11 +263,23 @@ func Test_executeWithoutPTY(t *testing.T) {
 func Test_executeScript_usesDetectedShell(t *testing.T) {
 	// Test that shebang is respected
 	script := "#!/bin/sh\necho $0"
-	result := executeScript(ContextDefault, script, "/bin/bash")
+	result := executeScript(ContextDefault, script, model.LanguageBash, config.ScriptConfig{Shell: "/bin/bash"})
 	// The output should indicate sh was used (contains "sh")
 	assert.Contains(t, result.stdout, "sh")
 }
 
+func Test_executeScript_shebangInterpreter(t *testing.T) {
+	if _, err := exec.LookPath("perl"); err != nil {
+		t.Skip("perl is not installed")
+	}
+
+	// the script is evaluated and not only checked for syntax errors
+	script := "#!/usr/bin/env perl\nprint \"hello\\n\";"
+	result := executeScript(ContextDefault, script, model.LanguageUnknown, config.ScriptConfig{Shell: "/bin/sh"})
+	assert.Equal(t, "hello\n", result.stdout)
+	assert.Equal(t, 0, result.exitCode)
+}
+
 func Test_executeScript_terminalDetection(t *testing.T) {
 	// Save original function
 	originalIsTerminal := isTerminalFunc
@@ -218,7 +287,7 @@ func Test_executeScript_terminalDetection(t *testing.T) {
 
 	// Test non-terminal path (default in tests)
 	isTerminalFunc = func(fd int) bool { return false }
-	result := executeScript(ContextDefault, "echo test", "/bin/sh")
+	result := executeScript(ContextDefault, "echo test", model.LanguageBash, config.ScriptConfig{Shell: "/bin/sh"})
 	assert.Equal(t, "test\n", result.stdout)
 	assert.Equal(t, "", result.stderr)
 }
@@ -226,25 +295,137 @@ func Test_executeScript_terminalDetection(t *testing.T) {
 func Test_formatOptions(t *testing.T) {
 	tests := []struct {
 		config   config.ScriptConfig
+		language model.Language
 		expected model.SnippetFormatOptions
 	}{
 		{
 			config:   config.ScriptConfig{RemoveComments: true, ParameterMode: config.ParameterModeSet},
+			language: model.LanguageBash,
 			expected: model.SnippetFormatOptions{RemoveComments: true, ParamMode: model.SnippetParamModeSet},
 		},
 		{
 			config:   config.ScriptConfig{RemoveComments: false, ParameterMode: config.ParameterModeReplace},
+			language: model.LanguageBash,
 			expected: model.SnippetFormatOptions{RemoveComments: false, ParamMode: model.SnippetParamModeReplace},
 		},
+		{
+			config:   config.ScriptConfig{ParameterMode: config.ParameterModeSet},
+			language: model.LanguagePython,
+			expected: model.SnippetFormatOptions{ParamMode: model.SnippetParamModeReplace},
+		},
+		{
+			config:   config.ScriptConfig{ParameterMode: config.ParameterModeSet, Interpreters: map[string]string{"python": ""}},
+			language: model.LanguagePython,
+			expected: model.SnippetFormatOptions{ParamMode: model.SnippetParamModeSet},
+		},
 	}
 
 	for i, tt := range tests {
 		t.Run(fmt.Sprintf("test %d", i), func(t *testing.T) {
-			assert.Equal(t, tt.expected, formatOptions(tt.config))
+			assert.Equal(t, tt.expected, formatOptions(tt.config, tt.language))
 		})
 	}
 }
 
+func Test_scriptCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		language model.Language
+		config   config.ScriptConfig
+		expected []string
+	}{
+		{"bash", "echo foo", model.LanguageBash, config.ScriptConfig{Shell: "/bin/zsh"}, []string{"/bin/zsh", "-c", "echo foo"}},
+		{"unknown", "echo foo", model.LanguageUnknown, config.ScriptConfig{Shell: "/bin/zsh"}, []string{"/bin/zsh", "-c", "echo foo"}},
+		{"yaml", "foo: bar", model.LanguageYAML, config.ScriptConfig{Shell: "/bin/sh"}, []string{"/bin/sh", "-c", "foo: bar"}},
+		{"python", "print(1)", model.LanguagePython, config.ScriptConfig{}, []string{"python3", "-c", "print(1)"}},
+		{"ruby", "puts 1", model.LanguageRuby, config.ScriptConfig{}, []string{"ruby", "-e", "puts 1"}},
+		{"javascript", "console.log(1)", model.LanguageJavaScript, config.ScriptConfig{}, []string{"node", "-e", "console.log(1)"}},
+		{"powershell", "Write-Output 1", model.LanguagePowerShell, config.ScriptConfig{}, []string{"pwsh", "-NoProfile", "-Command", "Write-Output 1"}},
+		{"perl", "print 1", model.LanguagePerl, config.ScriptConfig{}, []string{"perl", "-e", "print 1"}},
+		{
+			"configured interpreter",
+			"print(1)",
+			model.LanguagePython,
+			config.ScriptConfig{Interpreters: map[string]string{"python": "/opt/python/bin/python -c"}},
+			[]string{"/opt/python/bin/python", "-c", "print(1)"},
+		},
+		{
+			"interpreter for language without default",
+			"SELECT 1",
+			model.LanguageSQL,
+			config.ScriptConfig{Interpreters: map[string]string{"sql": "sqlite3 test.db"}},
+			[]string{"sqlite3", "test.db", "SELECT 1"},
+		},
+		{
+			"empty interpreter uses shell",
+			"print(1)",
+			model.LanguagePython,
+			config.ScriptConfig{Shell: "/bin/sh", Interpreters: map[string]string{"python": ""}},
+			[]string{"/bin/sh", "-c", "print(1)"},
+		},
+		{
+			"shebang takes priority over interpreter",
+			"#!/usr/bin/python2\nprint 1",
+			model.LanguagePython,
+			config.ScriptConfig{},
+			[]string{"/usr/bin/python2", "-c", "#!/usr/bin/python2\nprint 1"},
+		},
+		{
+			"bash shebang",
+			"#!/bin/bash -e\necho 1",
+			model.LanguageRuby,
+			config.ScriptConfig{Shell: "/bin/zsh"},
+			[]string{"/bin/bash", "-c", "#!/bin/bash -e\necho 1"},
+		},
+		{
+			"ruby shebang",
+			"#!/usr/bin/env ruby\nputs 1",
+			model.LanguageUnknown,
+			config.ScriptConfig{Shell: "/bin/zsh"},
+			[]string{"ruby", "-e", "#!/usr/bin/env ruby\nputs 1"},
+		},
+		{
+			"perl shebang",
+			"#!/usr/bin/perl\nprint 1",
+			model.LanguageUnknown,
+			config.ScriptConfig{Shell: "/bin/zsh"},
+			[]string{"/usr/bin/perl", "-e", "#!/usr/bin/perl\nprint 1"},
+		},
+		{
+			"node shebang",
+			"#!/usr/bin/env node\nconsole.log(1)",
+			model.LanguageJavaScript,
+			config.ScriptConfig{},
+			[]string{"node", "-e", "#!/usr/bin/env node\nconsole.log(1)"},
+		},
+		{
+			"powershell shebang",
+			"#!/usr/bin/env pwsh\nWrite-Output 1",
+			model.LanguagePowerShell,
+			config.ScriptConfig{},
+			[]string{"pwsh", "-NoProfile", "-Command", "#!/usr/bin/env pwsh\nWrite-Output 1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, scriptCommand(tt.script, tt.language, tt.config))
+		})
+	}
+}
+
+func Test_snippetLanguage(t *testing.T) {
+	assert.Equal(t, model.LanguageBash, snippetLanguage(testutil.TestSnippet{Language: model.LanguageBash, Content: "print('foo')"}))
+	assert.Equal(t, model.LanguagePython, snippetLanguage(testutil.TestSnippet{Content: "#!/usr/bin/env python3\nprint('foo')"}))
+	assert.Equal(t, model.LanguageText, snippetLanguage(testutil.TestSnippet{Language: model.LanguageText, Content: `{"foo": 1}`}))
+	assert.Equal(t, model.LanguageUnknown, snippetLanguage(testutil.TestSnippet{Content: "echo foo"}))
+
+	// content based detection is not used for execution
+	assert.Equal(t, model.LanguageUnknown, snippetLanguage(testutil.TestSnippet{Content: "let x = 1"}))
+	assert.Equal(t, model.LanguageUnknown, snippetLanguage(testutil.TestSnippet{Content: "print('foo')"}))
+}
+
 // saveTermFuncs saves and returns a restore function for all terminal function variables.
 func saveTermFuncs() func() {
 	origIsTerminal := isTerminalFunc
@@ -284,7 +465,7 @@ func Test_executeWithPTY_TermSizeError(t *testing.T) {
 			return nil, errors.New("cannot make raw")
 		}
 
-		result := executeScript(ContextDefault, "echo hello", "/bin/sh")
+		result := executeScript(ContextDefault, "echo hello", model.LanguageBash, config.ScriptConfig{Shell: "/bin/sh"})
 		assert.Contains(t, result.stdout, "hello")
 	})
 }
@@ -310,7 +491,7 @@ func Test_executeWithPTY_MakeRawError(t *testing.T) {
 			return nil, errors.New("cannot set raw mode")
 		}
 
-		result := executeScript(ContextDefault, "echo 'test output'", "/bin/sh")
+		result := executeScript(ContextDefault, "echo 'test output'", model.LanguageBash, config.ScriptConfig{Shell: "/bin/sh"})
 		assert.Contains(t, result.stdout, "test output")
 	})
 }
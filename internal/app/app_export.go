@@ -3,6 +3,7 @@ package app
 import (
 	"encoding/json"
 	"encoding/xml"
+	"time"
 
 	"golang.org/x/exp/slices"
 
@@ -16,6 +17,7 @@ const (
 	ExportFieldTitle      ExportField = 1
 	ExportFieldContent    ExportField = 2
 	ExportFieldParameters ExportField = 3
+	ExportFieldMetadata   ExportField = 4
 )
 
 type ExportFormat int64
@@ -85,9 +87,33 @@ func convertSnippetToJSON(snippet model.Snippet, fields []ExportField) snippetJS
 			}
 			return []parameterJSON{}
 		}(),
+		Metadata: func() *metadataJSON {
+			if slices.Contains(fields, ExportFieldMetadata) {
+				return convertMetadataToJSON(snippet.GetMetadata())
+			}
+			return nil
+		}(),
+	}
+}
+
+func convertMetadataToJSON(metadata model.SnippetMetadata) *metadataJSON {
+	return &metadataJSON{
+		Description: metadata.Description,
+		Manager:     string(metadata.Manager),
+		Source:      metadata.Source,
+		Created:     formatExportTime(metadata.Created),
+		Modified:    formatExportTime(metadata.Modified),
+		ReadOnly:    metadata.ReadOnly,
 	}
 }
 
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func convertParametersToJSON(parameters []model.Parameter) []parameterJSON {
 	result := make([]parameterJSON, len(parameters))
 	for i, v := range parameters {
@@ -112,6 +138,16 @@ type snippetJSON struct {
 	Title      string          `json:"title,omitempty" xml:"title,omitempty"`
 	Content    string          `json:"content,omitempty" xml:"content,omitempty"`
 	Parameters []parameterJSON `json:"parameters,omitempty" xml:"parameters,omitempty"`
+	Metadata   *metadataJSON   `json:"metadata,omitempty" xml:"metadata,omitempty"`
+}
+
+type metadataJSON struct {
+	Description string `json:"description,omitempty" xml:"description,omitempty"`
+	Manager     string `json:"manager,omitempty" xml:"manager,omitempty"`
+	Source      string `json:"source,omitempty" xml:"source,omitempty"`
+	Created     string `json:"created,omitempty" xml:"created,omitempty"`
+	Modified    string `json:"modified,omitempty" xml:"modified,omitempty"`
+	ReadOnly    bool   `json:"readOnly" xml:"readOnly"`
 }
 
 type parameterJSON struct {
@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -53,6 +54,26 @@ func Test_ExportSnippets(t *testing.T) {
 	}
 }
 
+func Test_ExportSnippets_metadata(t *testing.T) {
+	snippets := []model.Snippet{
+		testutil.TestSnippet{ID: "uuid1", Metadata: model.SnippetMetadata{
+			Description: "Prints foo",
+			Manager:     "fslibrary",
+			Source:      "/snippets/foo.sh",
+			Modified:    time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		}},
+		testutil.TestSnippet{ID: "uuid2", Metadata: model.SnippetMetadata{Manager: "tldr", ReadOnly: true}},
+	}
+
+	app := NewApp(WithConfig(configtest.NewTestConfig().Config), withManagerSnippets(snippets))
+
+	assert.Equal(t,
+		`{"snippets":[{"id":"uuid1","metadata":{"description":"Prints foo","manager":"fslibrary","source":"/snippets/foo.sh",`+
+			`"modified":"2024-03-01T12:00:00Z","readOnly":false}},{"id":"uuid2","metadata":{"manager":"tldr","readOnly":true}}]}`,
+		app.ExportSnippets([]ExportField{ExportFieldID, ExportFieldMetadata}, ExportFormatJSON),
+	)
+}
+
 func Test_ExportSnippets_formats(t *testing.T) {
 	snippets := []model.Snippet{
 		testutil.TestSnippet{ID: "uuid1", Title: "title-1", Content: "content-1"},
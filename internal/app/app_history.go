@@ -0,0 +1,288 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const (
+	historyKey    = cache.DataKey("history.jsonl")
+	redactedValue = "<redacted>"
+
+	// maxHistoryEntries is the number of executions kept in the history. Older executions are dropped.
+	maxHistoryEntries = 1000
+)
+
+var (
+	ErrNoHistory           = errors.New("No snippets have been executed yet.")
+	ErrSnippetNotInHistory = errors.New("The snippet with the given ID has not been executed yet.")
+)
+
+// historyEntry is a single execution of a snippet. The values of password parameters are never stored.
+type historyEntry struct {
+	SnippetID  string             `json:"snippetId"`
+	Title      string             `json:"title"`
+	Manager    model.ManagerKey   `json:"manager,omitempty"`
+	Parameters []historyParameter `json:"parameters,omitempty"`
+	Directory  string             `json:"directory,omitempty"`
+	Start      time.Time          `json:"start"`
+	DurationMS int64              `json:"durationMs"`
+	ExitCode   int                `json:"exitCode"`
+}
+
+type historyParameter struct {
+	Key      string `json:"key"`
+	Value    string `json:"value,omitempty"`
+	Redacted bool   `json:"redacted,omitempty"`
+}
+
+type historyJSON struct {
+	Executions []historyEntry `json:"executions"`
+}
+
+// BrowseHistory shows all executions, the most recent one first. The selected execution is repeated with the same
+// parameter values and its exit code is returned. If the history is closed without selecting an execution, 0 is
+// returned.
+func (a *appImpl) BrowseHistory() int {
+	entries := a.mustLoadHistory()
+	if entry, ok := a.pickHistoryEntry(entries); ok {
+		return a.rerun(entry)
+	}
+	return 0
+}
+
+// ExportHistory returns all executions as JSON in the order they were executed.
+func (a *appImpl) ExportHistory() string {
+	result, err := json.MarshalIndent(historyJSON{Executions: a.loadHistory()}, "", "    ")
+	if err != nil {
+		panic(errors.Wrap(err, "failed to serialize history"))
+	}
+	return string(result)
+}
+
+// RerunSnippet executes a snippet again with the parameter values of a previous execution. The execution is either the
+// most recent one, the most recent one of the snippet with the given ID or picked from the history by the user.
+// Values of password parameters have to be entered again.
+func (a *appImpl) RerunSnippet(last bool, snippetID string) int {
+	entries := a.mustLoadHistory()
+
+	var entry historyEntry
+	switch {
+	case snippetID != "":
+		found := false
+		for i := len(entries) - 1; i >= 0 && !found; i-- {
+			if entries[i].SnippetID == snippetID {
+				entry, found = entries[i], true
+			}
+		}
+		if !found {
+			panic(ErrSnippetNotInHistory)
+		}
+	case last:
+		entry = entries[len(entries)-1]
+	default:
+		picked, ok := a.pickHistoryEntry(entries)
+		if !ok {
+			return ExitCodeCancelled
+		}
+		entry = picked
+	}
+
+	return a.rerun(entry)
+}
+
+// pickHistoryEntry lets the user select one of the entries via the lookup, the most recent one first.
+func (a *appImpl) pickHistoryEntry(entries []historyEntry) (historyEntry, bool) {
+	index := a.tui.ShowLookup(historySnippets(entries), a.config.FuzzySearch)
+	if index < 0 {
+		return historyEntry{}, false
+	}
+	return entries[len(entries)-1-index], true
+}
+
+func (a *appImpl) rerun(entry historyEntry) int {
+	return a.FindScriptAndExecuteWithParameters(entry.SnippetID, entry.parameterValues(), false, false)
+}
+
+// recordExecution appends the execution to the history. A failure to record the execution is logged only since it
+// must not affect the execution itself.
+func (a *appImpl) recordExecution(snippet model.Snippet, parameterValues []string, start time.Time, output *capturedOutput) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Warn().Msgf("Failed to record execution: %v", err)
+		}
+	}()
+
+	directory, _ := os.Getwd()
+	entry := historyEntry{
+		SnippetID:  snippet.GetID(),
+		Title:      snippet.GetTitle(),
+		Manager:    snippet.GetMetadata().Manager,
+		Parameters: historyParameters(snippet.GetParameters(), parameterValues),
+		Directory:  directory,
+		Start:      start,
+		DurationMS: output.duration.Milliseconds(),
+		ExitCode:   output.exitCode,
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		panic(err)
+	}
+	a.cache.AppendData(historyKey, append(data, '\n'))
+	a.truncateHistory()
+}
+
+// truncateHistory drops the oldest executions if the history contains more than maxHistoryEntries executions.
+func (a *appImpl) truncateHistory() {
+	data, ok := a.cache.GetData(historyKey)
+	if !ok {
+		return
+	}
+
+	lines := bytes.SplitAfter(bytes.TrimRight(data, "\n"), []byte("\n"))
+	if len(lines) <= maxHistoryEntries {
+		return
+	}
+
+	truncated := bytes.Join(lines[len(lines)-maxHistoryEntries:], nil)
+	a.cache.PutData(historyKey, append(bytes.TrimRight(truncated, "\n"), '\n'))
+}
+
+func (a *appImpl) loadHistory() []historyEntry {
+	data, ok := a.cache.GetData(historyKey)
+	if !ok {
+		return []historyEntry{}
+	}
+
+	result := []historyEntry{}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry historyEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			log.Warn().Err(err).Msg("Skipping invalid history entry")
+			continue
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func (a *appImpl) mustLoadHistory() []historyEntry {
+	entries := a.loadHistory()
+	if len(entries) == 0 {
+		panic(ErrNoHistory)
+	}
+	return entries
+}
+
+func historyParameters(parameters []model.Parameter, values []string) []historyParameter {
+	var result []historyParameter
+	for i, parameter := range parameters {
+		if i >= len(values) {
+			break
+		}
+		if parameter.Type == model.ParameterTypePassword {
+			result = append(result, historyParameter{Key: parameter.Key, Redacted: true})
+		} else {
+			result = append(result, historyParameter{Key: parameter.Key, Value: values[i]})
+		}
+	}
+	return result
+}
+
+// parameterValues returns the values of all parameters which are not redacted.
+func (e historyEntry) parameterValues() []model.ParameterValue {
+	result := []model.ParameterValue{}
+	for _, parameter := range e.Parameters {
+		if !parameter.Redacted {
+			result = append(result, model.ParameterValue{Key: parameter.Key, Value: parameter.Value})
+		}
+	}
+	return result
+}
+
+// historySnippets returns the entries as snippets so that they can be shown via the lookup, the most recent one first.
+func historySnippets(entries []historyEntry) []model.Snippet {
+	result := make([]model.Snippet, len(entries))
+	for i := range entries {
+		result[len(entries)-1-i] = historySnippet{entry: entries[i]}
+	}
+	return result
+}
+
+// historySnippet shows the details of an execution in the lookup.
+type historySnippet struct {
+	entry historyEntry
+}
+
+func (s historySnippet) GetID() string {
+	return s.entry.SnippetID
+}
+
+func (s historySnippet) GetTitle() string {
+	title := fmt.Sprintf("%s  %s", s.entry.Start.Local().Format(time.DateTime), s.entry.Title)
+	if s.entry.ExitCode != 0 {
+		title += fmt.Sprintf(" (exit %d)", s.entry.ExitCode)
+	}
+	return title
+}
+
+func (s historySnippet) GetContent() string {
+	var sb strings.Builder
+	lines := []model.InfoLine{
+		{Key: "Snippet", Value: s.entry.Title},
+		{Key: "ID", Value: s.entry.SnippetID},
+		{Key: "Directory", Value: s.entry.Directory},
+		{Key: "Started", Value: s.entry.Start.Local().Format(time.DateTime)},
+		{Key: "Duration", Value: (time.Duration(s.entry.DurationMS) * time.Millisecond).String()},
+		{Key: "Exit code", Value: fmt.Sprint(s.entry.ExitCode)},
+	}
+	for _, line := range lines {
+		sb.WriteString(fmt.Sprintf("# %-10s %s\n", line.Key+":", line.Value))
+	}
+
+	if len(s.entry.Parameters) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, parameter := range s.entry.Parameters {
+		if parameter.Redacted {
+			sb.WriteString(fmt.Sprintf("%s=%s\n", parameter.Key, redactedValue))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s=%q\n", parameter.Key, parameter.Value))
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func (s historySnippet) GetTags() []string {
+	return nil
+}
+
+func (s historySnippet) GetLanguage() model.Language {
+	return model.LanguageBash
+}
+
+func (s historySnippet) GetParameters() []model.Parameter {
+	return nil
+}
+
+func (s historySnippet) Format(_ []string, _ model.SnippetFormatOptions) string {
+	return s.GetContent()
+}
+
+func (s historySnippet) GetMetadata() model.SnippetMetadata {
+	return model.SnippetMetadata{Manager: s.entry.Manager, ReadOnly: true}
+}
@@ -0,0 +1,192 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/config/configtest"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
+	uiMocks "github.com/lemoony/snipkit/mocks/ui"
+)
+
+const testHistorySnippetContent = `# ${ENV} Name: Environment
+# ${TOKEN} Name: Token
+# ${TOKEN} Type: PASSWORD
+exit 2`
+
+func newHistoryTestApp(t *testing.T, tui *uiMocks.TUI, c cache.Cache) App {
+	t.Helper()
+
+	snippet := testutil.TestSnippet{
+		ID:       "deploy",
+		Title:    "Deploy",
+		Language: model.LanguageBash,
+		Content:  testHistorySnippetContent,
+		Metadata: model.SnippetMetadata{Manager: model.ManagerKey("fslibrary")},
+	}
+
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+
+	cfg := configtest.NewTestConfig().Config
+	cfg.Script.Shell = "/bin/sh"
+
+	return NewApp(WithTUI(tui), WithConfig(cfg), withManagerSnippets([]model.Snippet{snippet}), withCache(c))
+}
+
+func Test_App_History_RecordExecution(t *testing.T) {
+	c := newTestCache()
+	app := newHistoryTestApp(t, &uiMocks.TUI{}, c)
+
+	values := []model.ParameterValue{{Key: "ENV", Value: "prod"}, {Key: "TOKEN", Value: "secret"}}
+	assert.Equal(t, 2, app.FindScriptAndExecuteWithParameters("deploy", values, false, false))
+
+	data, ok := c.GetData(historyKey)
+	assert.True(t, ok)
+	assert.NotContains(t, string(data), "secret")
+
+	var export historyJSON
+	assert.NoError(t, json.Unmarshal([]byte(app.ExportHistory()), &export))
+	assert.Len(t, export.Executions, 1)
+
+	entry := export.Executions[0]
+	assert.Equal(t, "deploy", entry.SnippetID)
+	assert.Equal(t, "Deploy", entry.Title)
+	assert.Equal(t, model.ManagerKey("fslibrary"), entry.Manager)
+	assert.Equal(t, []historyParameter{{Key: "ENV", Value: "prod"}, {Key: "TOKEN", Redacted: true}}, entry.Parameters)
+	assert.NotEmpty(t, entry.Directory)
+	assert.Equal(t, 2, entry.ExitCode)
+	assert.WithinDuration(t, time.Now(), entry.Start, time.Minute)
+}
+
+func Test_App_History_Rerun(t *testing.T) {
+	tests := []struct {
+		name      string
+		last      bool
+		snippetID string
+		lookup    int
+	}{
+		{name: "last", last: true},
+		{name: "snippet ID", snippetID: "deploy"},
+		{name: "picked from history", lookup: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestCache()
+			tui := &uiMocks.TUI{}
+			tui.On("ShowLookup", mock.Anything, mock.Anything).Return(tt.lookup)
+			tui.On("ShowParameterForm", mock.Anything, mock.Anything, mock.Anything).Return([]string{"prod", "secret"}, true)
+
+			app := newHistoryTestApp(t, tui, c)
+			app.FindScriptAndExecuteWithParameters("deploy", []model.ParameterValue{{Key: "ENV", Value: "prod"}, {Key: "TOKEN", Value: "x"}}, false, false)
+
+			assert.Equal(t, 2, app.RerunSnippet(tt.last, tt.snippetID))
+
+			// the password has to be entered again, all other values are prefilled
+			tui.AssertCalled(t, "ShowParameterForm", mock.Anything, []model.ParameterValue{{Key: "ENV", Value: "prod"}}, ui.OkButtonExecute)
+			assert.Len(t, app.(*appImpl).loadHistory(), 2)
+		})
+	}
+}
+
+func Test_App_History_RerunCancelled(t *testing.T) {
+	c := newTestCache()
+	c.AppendData(historyKey, []byte(`{"snippetId":"deploy","title":"Deploy"}`+"\n"))
+
+	tui := &uiMocks.TUI{}
+	tui.On("ShowLookup", mock.Anything, mock.Anything).Return(-1)
+
+	app := newHistoryTestApp(t, tui, c)
+	assert.Equal(t, ExitCodeCancelled, app.RerunSnippet(false, ""))
+}
+
+func Test_App_History_Errors(t *testing.T) {
+	c := newTestCache()
+	app := newHistoryTestApp(t, &uiMocks.TUI{}, c)
+
+	assert.PanicsWithValue(t, ErrNoHistory, func() { app.RerunSnippet(true, "") })
+	assert.PanicsWithValue(t, ErrNoHistory, func() { app.BrowseHistory() })
+	assert.JSONEq(t, `{"executions": []}`, app.ExportHistory())
+
+	c.AppendData(historyKey, []byte("invalid\n"+`{"snippetId":"deploy","title":"Deploy"}`+"\n"))
+	assert.PanicsWithValue(t, ErrSnippetNotInHistory, func() { app.RerunSnippet(false, "unknown") })
+}
+
+func Test_App_History_Browse(t *testing.T) {
+	c := newTestCache()
+	c.AppendData(historyKey, []byte(
+		`{"snippetId":"a","title":"First","start":"2024-01-01T10:00:00Z","exitCode":0}`+"\n"+
+			`{"snippetId":"b","title":"Second","start":"2024-01-02T10:00:00Z","exitCode":1}`+"\n",
+	))
+
+	tui := &uiMocks.TUI{}
+	tui.On("ShowLookup", mock.Anything, mock.Anything).Return(-1)
+
+	app := newHistoryTestApp(t, tui, c)
+	assert.Equal(t, 0, app.BrowseHistory())
+
+	snippets := tui.Calls[len(tui.Calls)-1].Arguments.Get(0).([]model.Snippet)
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, "b", snippets[0].GetID())
+	assert.Contains(t, snippets[0].GetTitle(), "Second (exit 1)")
+	assert.Equal(t, "a", snippets[1].GetID())
+	assert.NotContains(t, snippets[1].GetTitle(), "exit")
+}
+
+func Test_App_History_BrowseRerun(t *testing.T) {
+	c := newTestCache()
+	tui := &uiMocks.TUI{}
+	tui.On("ShowLookup", mock.Anything, mock.Anything).Return(0)
+	tui.On("ShowParameterForm", mock.Anything, mock.Anything, mock.Anything).Return([]string{"prod", "secret"}, true)
+
+	app := newHistoryTestApp(t, tui, c)
+	app.FindScriptAndExecuteWithParameters("deploy", []model.ParameterValue{{Key: "ENV", Value: "prod"}, {Key: "TOKEN", Value: "x"}}, false, false)
+
+	assert.Equal(t, 2, app.BrowseHistory())
+
+	tui.AssertCalled(t, "ShowParameterForm", mock.Anything, []model.ParameterValue{{Key: "ENV", Value: "prod"}}, ui.OkButtonExecute)
+	assert.Len(t, app.(*appImpl).loadHistory(), 2)
+}
+
+func Test_App_History_Truncate(t *testing.T) {
+	c := newTestCache()
+	for i := 0; i < maxHistoryEntries; i++ {
+		c.AppendData(historyKey, []byte(fmt.Sprintf(`{"snippetId":"old-%d","title":"Old"}`, i)+"\n"))
+	}
+
+	app := newHistoryTestApp(t, &uiMocks.TUI{}, c)
+	app.FindScriptAndExecuteWithParameters("deploy", []model.ParameterValue{{Key: "ENV", Value: "prod"}, {Key: "TOKEN", Value: "x"}}, false, false)
+
+	entries := app.(*appImpl).loadHistory()
+	assert.Len(t, entries, maxHistoryEntries)
+	assert.Equal(t, "old-1", entries[0].SnippetID)
+	assert.Equal(t, "deploy", entries[len(entries)-1].SnippetID)
+}
+
+func Test_historySnippet_GetContent(t *testing.T) {
+	snippet := historySnippet{entry: historyEntry{
+		SnippetID:  "deploy",
+		Title:      "Deploy",
+		Directory:  "/tmp",
+		DurationMS: 1500,
+		ExitCode:   1,
+		Parameters: []historyParameter{{Key: "ENV", Value: "prod"}, {Key: "TOKEN", Redacted: true}},
+	}}
+
+	content := snippet.GetContent()
+	assert.Contains(t, content, "# Snippet:   Deploy")
+	assert.Contains(t, content, "# Directory: /tmp")
+	assert.Contains(t, content, "# Duration:  1.5s")
+	assert.Contains(t, content, "# Exit code: 1")
+	assert.Contains(t, content, `ENV="prod"`)
+	assert.Contains(t, content, "TOKEN=<redacted>")
+}
@@ -2,17 +2,80 @@ package app
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/lemoony/snipkit/internal/managers"
 	"github.com/lemoony/snipkit/internal/model"
 )
 
 func (a *appImpl) Info() {
 	a.printInfo(a.configService.Info())
 	for _, manager := range a.managers {
-		a.printInfo(manager.Info())
+		a.printInfo(managerInfo(manager))
 	}
 }
 
+// SnippetInfo prints the metadata of the snippet with the given ID. If no ID is given, the snippet is looked up.
+func (a *appImpl) SnippetInfo(id string) {
+	var snippet model.Snippet
+	if id == "" {
+		ok, found := a.LookupSnippet()
+		if !ok {
+			return
+		}
+		snippet = found
+	} else if ok, found := a.getSnippet(id); ok {
+		snippet = found
+	} else {
+		panic(ErrSnippetIDNotFound)
+	}
+
+	a.printInfo(snippetInfo(snippet))
+}
+
+func snippetInfo(snippet model.Snippet) []model.InfoLine {
+	metadata := snippet.GetMetadata()
+
+	lines := []model.InfoLine{
+		{Key: "ID", Value: snippet.GetID()},
+		{Key: "Title", Value: snippet.GetTitle()},
+	}
+	optional := []model.InfoLine{
+		{Key: "Description", Value: metadata.Description},
+		{Key: "Tags", Value: strings.Join(snippet.GetTags(), ", ")},
+		{Key: "Manager", Value: string(metadata.Manager)},
+		{Key: "Source", Value: metadata.Source},
+		{Key: "Created", Value: formatInfoTime(metadata.Created)},
+		{Key: "Modified", Value: formatInfoTime(metadata.Modified)},
+	}
+	for _, line := range optional {
+		if line.Value != "" {
+			lines = append(lines, line)
+		}
+	}
+	return append(lines, model.InfoLine{Key: "Read-only", Value: strconv.FormatBool(metadata.ReadOnly)})
+}
+
+func formatInfoTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format(time.DateTime)
+}
+
+// managerInfo returns the info lines of the manager. If the manager fails, a single error line is returned instead so
+// that the info of all other managers is still printed.
+func managerInfo(manager managers.Manager) (lines []model.InfoLine) {
+	defer func() {
+		if panicValue := recover(); panicValue != nil {
+			lines = []model.InfoLine{{IsError: true, Key: fmt.Sprintf("%s failed", manager.Key()), Value: fmt.Sprint(panicValue)}}
+		}
+	}()
+	return manager.Info()
+}
+
 func (a *appImpl) printInfo(info []model.InfoLine) {
 	for _, line := range info {
 		if line.IsError {
@@ -1,12 +1,16 @@
 package app
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/lemoony/snipkit/internal/config/configtest"
 	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
 	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
 	configMocks "github.com/lemoony/snipkit/mocks/config"
 	managerMocks "github.com/lemoony/snipkit/mocks/managers"
@@ -45,3 +49,60 @@ func Test_App_Info(t *testing.T) {
 	tui.AssertCalled(t, mockutil.PrintMessage, "Some-Key: Some-Value")
 	tui.AssertCalled(t, mockutil.PrintError, "Some-Error: Some-Error")
 }
+
+func Test_App_Info_FailingManager(t *testing.T) {
+	tui := uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.PrintMessage, mock.Anything)
+	tui.On(mockutil.PrintError, mock.Anything)
+
+	cfgService := configMocks.ConfigService{}
+	cfgService.On("LoadConfig").Return(configtest.NewTestConfig().Config, nil)
+	cfgService.On("NeedsMigration").Return(false, "")
+	cfgService.On("Info").Return([]model.InfoLine{})
+
+	failingManager := managerMocks.Manager{}
+	failingManager.On("Key").Return(model.ManagerKey("failing"))
+	failingManager.On("Info").Panic("file is corrupted")
+
+	manager := managerMocks.Manager{}
+	manager.On("Info").Return([]model.InfoLine{{Key: "Some-Key", Value: "Some-Value"}})
+
+	app := NewApp(WithTUI(&tui), WithConfigService(&cfgService), withManager(&failingManager, &manager))
+	app.Info()
+
+	tui.AssertCalled(t, mockutil.PrintError, "failing failed: file is corrupted")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Some-Key: Some-Value")
+}
+
+func Test_App_SnippetInfo(t *testing.T) {
+	tui := uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.PrintMessage, mock.Anything)
+
+	snippet := testutil.TestSnippet{ID: "foo", Title: "Foo", Tags: []string{"a", "b"}, Metadata: model.SnippetMetadata{
+		Description: "Prints foo",
+		Manager:     "fslibrary",
+		Source:      "/snippets/foo.sh",
+		Modified:    time.Date(2024, 3, 1, 12, 0, 0, 0, time.Local),
+	}}
+
+	app := NewApp(WithTUI(&tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}))
+	app.SnippetInfo("foo")
+
+	tui.AssertCalled(t, mockutil.PrintMessage, "ID: foo")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Title: Foo")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Description: Prints foo")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Tags: a, b")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Manager: fslibrary")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Source: /snippets/foo.sh")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Modified: 2024-03-01 12:00:00")
+	tui.AssertCalled(t, mockutil.PrintMessage, "Read-only: false")
+	tui.AssertNotCalled(t, mockutil.PrintMessage, mock.MatchedBy(func(line string) bool {
+		return strings.HasPrefix(line, "Created")
+	}))
+
+	assert.PanicsWithValue(t, ErrSnippetIDNotFound, func() {
+		app.SnippetInfo("bar")
+	})
+}
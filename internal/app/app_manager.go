@@ -54,6 +54,33 @@ func (a *appImpl) AddManager() {
 		if cfg.GithubGist != nil {
 			newConfig.Manager.GithubGist = cfg.GithubGist
 		}
+		if cfg.GitRepository != nil {
+			newConfig.Manager.GitRepository = cfg.GitRepository
+		}
+		if cfg.GitLab != nil {
+			newConfig.Manager.GitLab = cfg.GitLab
+		}
+		if cfg.Navi != nil {
+			newConfig.Manager.Navi = cfg.Navi
+		}
+		if cfg.Tldr != nil {
+			newConfig.Manager.Tldr = cfg.Tldr
+		}
+		if cfg.ShellHistory != nil {
+			newConfig.Manager.ShellHistory = cfg.ShellHistory
+		}
+		if cfg.Cheat != nil {
+			newConfig.Manager.Cheat = cfg.Cheat
+		}
+		if cfg.VSCode != nil {
+			newConfig.Manager.VSCode = cfg.VSCode
+		}
+		if cfg.MarkdownNotebook != nil {
+			newConfig.Manager.MarkdownNotebook = cfg.MarkdownNotebook
+		}
+		if cfg.ProjectTasks != nil {
+			newConfig.Manager.ProjectTasks = cfg.ProjectTasks
+		}
 
 		// Serialize new config
 		newConfigBytes := config.SerializeToYamlWithComment(config.Wrap(newConfig))
@@ -9,12 +9,21 @@ import (
 
 	"github.com/lemoony/snipkit/internal/config/configtest"
 	"github.com/lemoony/snipkit/internal/managers"
+	"github.com/lemoony/snipkit/internal/managers/cheat"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
 	"github.com/lemoony/snipkit/internal/managers/githubgist"
+	"github.com/lemoony/snipkit/internal/managers/gitlab"
+	"github.com/lemoony/snipkit/internal/managers/gitrepo"
 	"github.com/lemoony/snipkit/internal/managers/masscode"
+	"github.com/lemoony/snipkit/internal/managers/navi"
+	"github.com/lemoony/snipkit/internal/managers/notebook"
 	"github.com/lemoony/snipkit/internal/managers/pet"
 	"github.com/lemoony/snipkit/internal/managers/pictarinesnip"
+	"github.com/lemoony/snipkit/internal/managers/shellhistory"
 	"github.com/lemoony/snipkit/internal/managers/snippetslab"
+	"github.com/lemoony/snipkit/internal/managers/tasks"
+	"github.com/lemoony/snipkit/internal/managers/tldr"
+	"github.com/lemoony/snipkit/internal/managers/vscode"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/ui/sync"
 	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
@@ -272,6 +281,33 @@ func getAddManagerTestCases() []struct {
 		{"GithubGist", githubgist.Key, "GitHub Gist", func() managers.Config {
 			return managers.Config{GithubGist: &githubgist.Config{Enabled: true}}
 		}},
+		{"GitRepository", gitrepo.Key, "Git Repository", func() managers.Config {
+			return managers.Config{GitRepository: &gitrepo.Config{Enabled: true}}
+		}},
+		{"GitLab", gitlab.Key, "GitLab Snippets", func() managers.Config {
+			return managers.Config{GitLab: &gitlab.Config{Enabled: true}}
+		}},
+		{"Navi", navi.Key, "navi - Interactive Cheatsheet Tool", func() managers.Config {
+			return managers.Config{Navi: &navi.Config{Enabled: true}}
+		}},
+		{"Tldr", tldr.Key, "tldr pages", func() managers.Config {
+			return managers.Config{Tldr: &tldr.Config{Enabled: true}}
+		}},
+		{"ShellHistory", shellhistory.Key, "Shell History", func() managers.Config {
+			return managers.Config{ShellHistory: &shellhistory.Config{Enabled: true}}
+		}},
+		{"Cheat", cheat.Key, "cheat - Interactive Cheatsheets", func() managers.Config {
+			return managers.Config{Cheat: &cheat.Config{Enabled: true}}
+		}},
+		{"VSCode", vscode.Key, "VS Code Snippets", func() managers.Config {
+			return managers.Config{VSCode: &vscode.Config{Enabled: true}}
+		}},
+		{"MarkdownNotebook", notebook.Key, "Markdown Notebook", func() managers.Config {
+			return managers.Config{MarkdownNotebook: &notebook.Config{Enabled: true}}
+		}},
+		{"ProjectTasks", tasks.Key, "Project tasks", func() managers.Config {
+			return managers.Config{ProjectTasks: &tasks.Config{Enabled: true}}
+		}},
 	}
 }
 
@@ -0,0 +1,220 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const (
+	parameterCommandsKey = cache.DataKey("parameter_commands.json")
+
+	// parameterCommandWaitDelay is the time to wait for the output to be closed after a command has been killed, e.g.,
+	// because a process started by the command is still running.
+	parameterCommandWaitDelay = time.Second
+
+	// parameterCommandCacheTTL is the time the output of a ValuesCommand or DefaultCommand is reused so that opening
+	// the parameter form repeatedly does not run slow commands again and again.
+	parameterCommandCacheTTL = 30 * time.Second
+)
+
+// parameterCommandTimeout is the maximum time a ValuesCommand or DefaultCommand may take. It can be overridden in tests.
+var parameterCommandTimeout = 5 * time.Second
+
+// commandOutput is the cached output of a ValuesCommand or DefaultCommand.
+type commandOutput struct {
+	Lines []string  `json:"lines"`
+	Time  time.Time `json:"time"`
+}
+
+// resolveParameterCommands returns the parameters with the output lines of their ValuesCommand added to the values
+// and the first output line of their DefaultCommand as default value. If a command fails, the error is set as
+// CommandError of the parameter. Parameters without commands are returned unchanged. If parameter commands are not
+// enabled via ScriptConfig.ParameterCommands, no command is executed and all parameters are returned unchanged.
+func (a *appImpl) resolveParameterCommands(parameters []model.Parameter) []model.Parameter {
+	if !a.parameterCommandsEnabled(parameters) {
+		return parameters
+	}
+
+	outputs := a.loadCommandOutputs()
+	defer a.storeCommandOutputs(outputs)
+
+	result := make([]model.Parameter, len(parameters))
+	for i, parameter := range parameters {
+		result[i] = parameter
+
+		var errs []string
+		if parameter.ValuesCommand != "" {
+			if lines, err := a.runParameterCommand(outputs, parameter.ValuesCommand); err != nil {
+				errs = append(errs, err.Error())
+			} else {
+				result[i].Values = slices.Clone(parameter.Values)
+				for _, line := range lines {
+					if !slices.Contains(result[i].Values, line) {
+						result[i].Values = append(result[i].Values, line)
+					}
+				}
+			}
+		}
+
+		if parameter.DefaultCommand != "" {
+			if lines, err := a.runParameterCommand(outputs, parameter.DefaultCommand); err != nil {
+				errs = append(errs, err.Error())
+			} else if len(lines) > 0 {
+				result[i].DefaultValue = lines[0]
+			}
+		}
+
+		result[i].CommandError = strings.Join(errs, "; ")
+	}
+	return result
+}
+
+// withCommandDefaults adds the output of the DefaultCommand of all parameters of the snippet for which no value is
+// given. This way, snippets can be executed non-interactively without passing values for such parameters. Nothing is
+// executed if parameter commands are not enabled via ScriptConfig.ParameterCommands.
+func (a *appImpl) withCommandDefaults(snippet model.Snippet, values []model.ParameterValue) []model.ParameterValue {
+	if !a.parameterCommandsEnabled(snippet.GetParameters()) {
+		return values
+	}
+
+	var missing []model.Parameter
+	for _, parameter := range snippet.GetParameters() {
+		if parameter.DefaultCommand != "" && !slices.ContainsFunc(values, func(v model.ParameterValue) bool {
+			return v.Key == parameter.Key
+		}) {
+			missing = append(missing, parameter)
+		}
+	}
+
+	if len(missing) == 0 {
+		return values
+	}
+
+	outputs := a.loadCommandOutputs()
+	defer a.storeCommandOutputs(outputs)
+
+	result := slices.Clone(values)
+	for _, parameter := range missing {
+		if lines, err := a.runParameterCommand(outputs, parameter.DefaultCommand); err != nil {
+			log.Warn().Err(err).Str("parameter", parameter.Key).Msg("Failed to compute default value")
+		} else if len(lines) > 0 {
+			result = append(result, model.ParameterValue{Key: parameter.Key, Value: lines[0]})
+		}
+	}
+	return result
+}
+
+// runParameterCommand returns the non-empty output lines of the command. The output is taken from outputs if the same
+// command was executed with the same shell in the same working directory not longer than parameterCommandCacheTTL
+// ago. Otherwise, the command is executed with the shell and the output is put into outputs.
+func (a *appImpl) runParameterCommand(outputs map[string]commandOutput, command string) ([]string, error) {
+	shell := detectShell("", a.config.Script.Shell)
+	directory, _ := os.Getwd()
+	key := commandCacheKey(shell, directory, command)
+	if output, ok := outputs[key]; ok && time.Since(output.Time) < parameterCommandCacheTTL {
+		return output.Lines, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), parameterCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	//nolint:gosec // since it would report G204 complaining about using a variable as input for exec.Command
+	cmd := exec.CommandContext(ctx, shell, "-c", command)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = parameterCommandWaitDelay
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, errors.Errorf("command timed out after %s", parameterCommandTimeout)
+		}
+		if msg := firstLine(stderr.String()); msg != "" {
+			return nil, errors.New(msg)
+		}
+		return nil, errors.Wrap(err, "command failed")
+	}
+
+	var lines []string
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	outputs[key] = commandOutput{Lines: lines, Time: time.Now()}
+	return lines, nil
+}
+
+// commandCacheKey returns the key of the cached output of a command. The output of commands such as `ls` or
+// `git branch` depends on the working directory and may differ between shells, so both are part of the key.
+func commandCacheKey(shell, dir, command string) string {
+	return strings.Join([]string{shell, dir, command}, "\x00")
+}
+
+func (a *appImpl) loadCommandOutputs() map[string]commandOutput {
+	result := map[string]commandOutput{}
+	if data, ok := a.cache.GetData(parameterCommandsKey); ok {
+		if err := json.Unmarshal(data, &result); err != nil {
+			log.Warn().Err(err).Msg("Ignoring invalid cached command outputs")
+			return map[string]commandOutput{}
+		}
+	}
+	return result
+}
+
+// storeCommandOutputs stores all outputs which have not expired yet. A failure to store the outputs is logged only
+// since they are a cache only.
+func (a *appImpl) storeCommandOutputs(outputs map[string]commandOutput) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Warn().Msgf("Failed to cache command outputs: %v", err)
+		}
+	}()
+
+	for key, output := range outputs {
+		if time.Since(output.Time) >= parameterCommandCacheTTL {
+			delete(outputs, key)
+		}
+	}
+
+	data, err := json.Marshal(outputs)
+	if err != nil {
+		panic(err)
+	}
+	a.cache.PutData(parameterCommandsKey, data)
+}
+
+// parameterCommandsEnabled returns true if any of the parameters has a command and parameter commands are enabled.
+// Since the commands of snippets are executed without any confirmation, they must be enabled explicitly.
+func (a *appImpl) parameterCommandsEnabled(parameters []model.Parameter) bool {
+	if !slices.ContainsFunc(parameters, hasParameterCommand) {
+		return false
+	}
+	if !a.config.Script.ParameterCommands {
+		log.Debug().Msg("Ignoring parameter commands since they are not enabled")
+		return false
+	}
+	return true
+}
+
+func hasParameterCommand(parameter model.Parameter) bool {
+	return parameter.ValuesCommand != "" || parameter.DefaultCommand != ""
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(line)
+}
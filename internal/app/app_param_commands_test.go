@@ -0,0 +1,181 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lemoony/snipkit/internal/config/configtest"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
+	uiMocks "github.com/lemoony/snipkit/mocks/ui"
+)
+
+const testCommandSnippetContent = `# ${NS} ValuesCommand: printf 'default\n\nkube-system\n'
+# ${NS} Values: default, custom
+# ${NS} DefaultCommand: echo kube-system
+# ${POD} ValuesCommand: echo "no cluster" >&2; exit 1
+echo "${NS} ${POD}"`
+
+func newCommandTestApp(t *testing.T, tui *uiMocks.TUI, content string) *appImpl {
+	t.Helper()
+
+	snippet := testutil.TestSnippet{ID: "pods", Title: "Pods", Language: model.LanguageBash, Content: content}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+
+	cfg := configtest.NewTestConfig().Config
+	cfg.Script.Shell = "/bin/sh"
+	cfg.Script.ParameterCommands = true
+
+	return NewApp(
+		WithTUI(tui), WithConfig(cfg), withManagerSnippets([]model.Snippet{snippet}), withCache(newTestCache()),
+	).(*appImpl)
+}
+
+func Test_App_ParameterCommands_Form(t *testing.T) {
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, mock.Anything).Return([]string{}, false)
+
+	app := newCommandTestApp(t, tui, testCommandSnippetContent)
+	assert.Equal(t, ExitCodeCancelled, app.FindScriptAndExecuteWithParameters("pods", nil, false, false))
+
+	parameters := tui.Calls[len(tui.Calls)-1].Arguments.Get(0).([]model.Parameter)
+	assert.Len(t, parameters, 2)
+
+	assert.Equal(t, []string{"default", "custom", "kube-system"}, parameters[0].Values)
+	assert.Equal(t, "kube-system", parameters[0].DefaultValue)
+	assert.Empty(t, parameters[0].CommandError)
+
+	assert.Empty(t, parameters[1].Values)
+	assert.Equal(t, "no cluster", parameters[1].CommandError)
+}
+
+func Test_App_ParameterCommands_NonInteractive(t *testing.T) {
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.Print, mock.Anything).Return()
+
+	app := newCommandTestApp(t, tui, `# ${NS} DefaultCommand: echo kube-system
+echo "${NS}"`)
+
+	ok, script := app.FindSnippetAndPrint("pods", nil)
+	assert.True(t, ok)
+	assert.Contains(t, script, `NS="kube-system"`)
+
+	// given values take precedence
+	ok, script = app.FindSnippetAndPrint("pods", []model.ParameterValue{{Key: "NS", Value: "default"}})
+	assert.True(t, ok)
+	assert.Contains(t, script, `NS="default"`)
+
+	tui.AssertNotCalled(t, mockutil.ShowParameterForm, mock.Anything, mock.Anything, ui.OkButtonExecute)
+}
+
+func Test_App_ParameterCommands_Disabled(t *testing.T) {
+	marker := filepath.Join(t.TempDir(), "executed")
+
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.Print, mock.Anything).Return()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, mock.Anything).Return([]string{}, false)
+
+	app := newCommandTestApp(t, tui, fmt.Sprintf(`# ${NS} ValuesCommand: touch %[1]s
+# ${NS} DefaultCommand: touch %[1]s && echo kube-system
+echo "${NS}"`, marker))
+	app.config.Script.ParameterCommands = false
+
+	assert.Equal(t, ExitCodeCancelled, app.FindScriptAndExecuteWithParameters("pods", nil, false, false))
+	parameters := tui.Calls[len(tui.Calls)-1].Arguments.Get(0).([]model.Parameter)
+	assert.Empty(t, parameters[0].Values)
+	assert.Empty(t, parameters[0].DefaultValue)
+	assert.Empty(t, parameters[0].CommandError)
+
+	ok, _ := app.FindSnippetAndPrint("pods", nil)
+	assert.False(t, ok)
+
+	assert.NoFileExists(t, marker)
+}
+
+func Test_App_ParameterCommands_Cache(t *testing.T) {
+	app := newCommandTestApp(t, &uiMocks.TUI{}, testCommandSnippetContent)
+
+	outputs := app.loadCommandOutputs()
+	lines, err := app.runParameterCommand(outputs, "date +%s%N")
+	assert.NoError(t, err)
+	app.storeCommandOutputs(outputs)
+
+	// the cached output is used
+	cached, err := app.runParameterCommand(app.loadCommandOutputs(), "date +%s%N")
+	assert.NoError(t, err)
+	assert.Equal(t, lines, cached)
+
+	// expired outputs are not stored
+	outputs["expired"] = commandOutput{Lines: []string{"foo"}, Time: time.Now().Add(-parameterCommandCacheTTL)}
+	app.storeCommandOutputs(outputs)
+
+	data, _ := app.cache.GetData(parameterCommandsKey)
+	var stored map[string]commandOutput
+	assert.NoError(t, json.Unmarshal(data, &stored))
+	assert.Contains(t, stored, commandCacheKey("/bin/sh", mustGetwd(t), "date +%s%N"))
+	assert.NotContains(t, stored, "expired")
+}
+
+func Test_App_ParameterCommands_CacheKey(t *testing.T) {
+	app := newCommandTestApp(t, &uiMocks.TUI{}, testCommandSnippetContent)
+	outputs := map[string]commandOutput{}
+
+	first, second := t.TempDir(), t.TempDir()
+
+	t.Chdir(first)
+	lines, err := app.runParameterCommand(outputs, "pwd -P")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{mustEvalSymlinks(t, first)}, lines)
+
+	// the output of the first directory must not be reused in another directory
+	t.Chdir(second)
+	lines, err = app.runParameterCommand(outputs, "pwd -P")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{mustEvalSymlinks(t, second)}, lines)
+	assert.Len(t, outputs, 2)
+
+	// the output must not be reused for another shell
+	app.config.Script.Shell = "/bin/bash"
+	_, err = app.runParameterCommand(outputs, "pwd -P")
+	assert.NoError(t, err)
+	assert.Len(t, outputs, 3)
+}
+
+func mustGetwd(t *testing.T) string {
+	t.Helper()
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+	return dir
+}
+
+func mustEvalSymlinks(t *testing.T, path string) string {
+	t.Helper()
+	resolved, err := filepath.EvalSymlinks(path)
+	assert.NoError(t, err)
+	return resolved
+}
+
+func Test_App_ParameterCommands_Errors(t *testing.T) {
+	app := newCommandTestApp(t, &uiMocks.TUI{}, testCommandSnippetContent)
+
+	_, err := app.runParameterCommand(map[string]commandOutput{}, "exit 3")
+	assert.EqualError(t, err, "command failed: exit status 3")
+
+	prevTimeout := parameterCommandTimeout
+	defer func() { parameterCommandTimeout = prevTimeout }()
+	parameterCommandTimeout = 100 * time.Millisecond
+
+	start := time.Now()
+	_, err = app.runParameterCommand(map[string]commandOutput{}, "sleep 10")
+	assert.EqualError(t, err, "command timed out after 100ms")
+	assert.Less(t, time.Since(start), 2*parameterCommandWaitDelay)
+}
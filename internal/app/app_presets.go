@@ -0,0 +1,168 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui"
+	"github.com/lemoony/snipkit/internal/ui/picker"
+)
+
+const (
+	presetsKey = cache.DataKey("parameter_presets.json")
+
+	noPresetTitle  = "No preset"
+	newPresetTitle = "New preset"
+)
+
+var ErrPresetNotFound = errors.New("No preset with the given name exists for the snippet.")
+
+// parameterPresets holds the parameter values of all presets per snippet ID and preset name.
+type parameterPresets map[string]map[string]map[string]string
+
+// PresetParameterValues returns the parameter values stored for the preset of the snippet. Values of parameters which
+// have been removed from the snippet in the meantime are omitted. It panics with ErrPresetNotFound if the snippet has
+// no preset with the given name.
+func (a *appImpl) PresetParameterValues(snippetID, preset string) []model.ParameterValue {
+	found, snippet := a.getSnippet(snippetID)
+	if !found {
+		panic(ErrSnippetIDNotFound)
+	}
+	return presetValues(snippet, a.loadPresets(), preset)
+}
+
+// SaveParameterPreset shows the parameter form prefilled with the given values and stores the submitted values as
+// preset with the given name. An existing preset with the same name is replaced. Values of password parameters are not
+// stored but returned together with all other values so that the snippet can be executed right away.
+func (a *appImpl) SaveParameterPreset(snippetID, preset string, values []model.ParameterValue) (bool, []model.ParameterValue) {
+	found, snippet := a.getSnippet(snippetID)
+	if !found {
+		panic(ErrSnippetIDNotFound)
+	}
+	return a.saveParameterPreset(snippet, preset, values)
+}
+
+// showLookupParameterForm returns the parameter values for a snippet selected via the lookup. If presets exist for the
+// snippet, the user can pick one of them, enter the values without preset or save the values as new preset. The form
+// is skipped if a preset provides the values of all parameters.
+func (a *appImpl) showLookupParameterForm(snippet model.Snippet, okButton ui.OkButton) ([]string, bool) {
+	presets := a.loadPresets()
+	names := slices.Sorted(maps.Keys(presets[snippet.GetID()]))
+	if len(names) == 0 || len(snippet.GetParameters()) == 0 {
+		return a.showParameterForm(snippet, nil, okButton)
+	}
+
+	items := []picker.Item{picker.NewItem(noPresetTitle, "Enter the parameter values in the form")}
+	for _, name := range names {
+		items = append(items, picker.NewItem(name, formatPresetValues(presetValues(snippet, presets, name))))
+	}
+	items = append(items, picker.NewItem(newPresetTitle, "Enter the parameter values and save them as new preset"))
+
+	index, ok := a.tui.ShowPicker(fmt.Sprintf("Which preset do you want to use for %s?", snippet.GetTitle()), items, nil)
+	switch {
+	case !ok:
+		return nil, false
+	case index == 0:
+		return a.showParameterForm(snippet, nil, okButton)
+	case index == len(items)-1:
+		return a.showNewPresetForm(snippet)
+	}
+
+	values := presetValues(snippet, presets, names[index-1])
+	if complete, result := matchParameters(values, snippet.GetParameters()); complete {
+		return result, true
+	}
+	return a.showParameterForm(snippet, values, okButton)
+}
+
+// showNewPresetForm asks for the name of a new preset and saves the parameter values entered afterward as preset.
+func (a *appImpl) showNewPresetForm(snippet model.Snippet) ([]string, bool) {
+	nameParameter := model.Parameter{Key: "PRESET", Name: "Preset name", Description: "Name of the new preset"}
+	name, ok := a.tui.ShowParameterForm([]model.Parameter{nameParameter}, nil, ui.OkButtonSave)
+	if !ok || len(name) == 0 || strings.TrimSpace(name[0]) == "" {
+		return nil, false
+	}
+
+	ok, values := a.saveParameterPreset(snippet, strings.TrimSpace(name[0]), nil)
+	if !ok {
+		return nil, false
+	}
+	_, result := matchParameters(values, snippet.GetParameters())
+	return result, true
+}
+
+func (a *appImpl) saveParameterPreset(snippet model.Snippet, preset string, values []model.ParameterValue) (bool, []model.ParameterValue) {
+	parameters := snippet.GetParameters()
+	formValues, ok := a.showParameterForm(snippet, values, ui.OkButtonSave)
+	if !ok {
+		return false, nil
+	}
+
+	presetValues := map[string]string{}
+	for i, parameter := range parameters {
+		if parameter.Type != model.ParameterTypePassword {
+			presetValues[parameter.Key] = formValues[i]
+		}
+	}
+
+	presets := a.loadPresets()
+	if presets[snippet.GetID()] == nil {
+		presets[snippet.GetID()] = map[string]map[string]string{}
+	}
+	presets[snippet.GetID()][preset] = presetValues
+
+	data, err := json.Marshal(presets)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to serialize presets"))
+	}
+	a.cache.PutData(presetsKey, data)
+
+	log.Debug().Str("snippet", snippet.GetID()).Str("preset", preset).Msg("Saved parameter preset")
+	return true, matchParameterToValues(parameters, formValues)
+}
+
+// loadPresets returns all stored presets. A corrupt presets file is logged and ignored so that snippets can still be
+// executed. The file is replaced as soon as a preset is saved.
+func (a *appImpl) loadPresets() parameterPresets {
+	result := parameterPresets{}
+	if data, ok := a.cache.GetData(presetsKey); ok {
+		if err := json.Unmarshal(data, &result); err != nil {
+			log.Warn().Err(err).Msg("Ignoring invalid parameter presets")
+			return parameterPresets{}
+		}
+	}
+	return result
+}
+
+// presetValues returns the values of the preset for all parameters of the snippet. It panics with ErrPresetNotFound if
+// the snippet has no preset with the given name.
+func presetValues(snippet model.Snippet, presets parameterPresets, preset string) []model.ParameterValue {
+	values, ok := presets[snippet.GetID()][preset]
+	if !ok {
+		panic(ErrPresetNotFound)
+	}
+
+	result := []model.ParameterValue{}
+	for _, parameter := range snippet.GetParameters() {
+		if value, exists := values[parameter.Key]; exists {
+			result = append(result, model.ParameterValue{Key: parameter.Key, Value: value})
+		}
+	}
+	return result
+}
+
+func formatPresetValues(values []model.ParameterValue) string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.Key + "=" + v.Value
+	}
+	return strings.Join(result, ", ")
+}
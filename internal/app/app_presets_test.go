@@ -0,0 +1,202 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lemoony/snipkit/internal/config/configtest"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui"
+	"github.com/lemoony/snipkit/internal/ui/picker"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
+	uiMocks "github.com/lemoony/snipkit/mocks/ui"
+)
+
+func Test_App_Presets(t *testing.T) {
+	snippet := testutil.TestSnippet{
+		ID:       "deploy",
+		Title:    "Deploy",
+		Language: model.LanguageBash,
+		Content: `# ${NAMESPACE} Name: Namespace
+# ${CLUSTER} Name: Cluster
+# ${TOKEN} Type: PASSWORD
+echo "${NAMESPACE}"`,
+	}
+
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, ui.OkButtonSave).Return([]string{"prod-ns", "prod-cluster", "secret"}, true)
+
+	c := newTestCache()
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(c))
+
+	given := []model.ParameterValue{{Key: "CLUSTER", Value: "prod-cluster"}}
+	ok, values := app.SaveParameterPreset("deploy", "prod", given)
+	assert.True(t, ok)
+	assert.Equal(t, []model.ParameterValue{
+		{Key: "NAMESPACE", Value: "prod-ns"},
+		{Key: "CLUSTER", Value: "prod-cluster"},
+		{Key: "TOKEN", Value: "secret"},
+	}, values)
+	tui.AssertCalled(t, mockutil.ShowParameterForm, snippet.GetParameters(), given, ui.OkButtonSave)
+
+	data, _ := c.GetData(presetsKey)
+	assert.NotContains(t, string(data), "secret")
+
+	assert.Equal(t, []model.ParameterValue{
+		{Key: "NAMESPACE", Value: "prod-ns"},
+		{Key: "CLUSTER", Value: "prod-cluster"},
+	}, app.PresetParameterValues("deploy", "prod"))
+
+	assert.PanicsWithValue(t, ErrPresetNotFound, func() { app.PresetParameterValues("deploy", "staging") })
+	assert.PanicsWithValue(t, ErrSnippetIDNotFound, func() { app.PresetParameterValues("unknown", "prod") })
+}
+
+func Test_App_Presets_SaveCancelled(t *testing.T) {
+	snippet := testutil.TestSnippet{ID: "deploy", Title: "Deploy", Language: model.LanguageBash, Content: testSnippetContent}
+
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, ui.OkButtonSave).Return([]string{}, false)
+
+	c := newTestCache()
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(c))
+
+	ok, values := app.SaveParameterPreset("deploy", "prod", nil)
+	assert.False(t, ok)
+	assert.Nil(t, values)
+
+	_, exists := c.GetData(presetsKey)
+	assert.False(t, exists)
+}
+
+func Test_App_Presets_Lookup(t *testing.T) {
+	snippet := testutil.TestSnippet{
+		ID:       "deploy",
+		Title:    "Deploy",
+		Language: model.LanguageBash,
+		Content: `# ${NAMESPACE} Name: Namespace
+# ${CLUSTER} Name: Cluster
+echo "${NAMESPACE} ${CLUSTER}"`,
+	}
+
+	tests := []struct {
+		name           string
+		pickerIndex    int
+		pickerOk       bool
+		expectedOk     bool
+		expectedValues []string
+		expectedForms  int
+	}{
+		{name: "preset", pickerIndex: 2, pickerOk: true, expectedOk: true, expectedValues: []string{"prod-ns", "prod-cluster"}},
+		{name: "no preset", pickerIndex: 0, pickerOk: true, expectedOk: true, expectedValues: []string{"form-ns", "form-cluster"}, expectedForms: 1},
+		{name: "new preset", pickerIndex: 3, pickerOk: true, expectedOk: true, expectedValues: []string{"form-ns", "form-cluster"}, expectedForms: 2},
+		{name: "picker cancelled", pickerIndex: -1, pickerOk: false, expectedOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tui := &uiMocks.TUI{}
+			tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+			tui.On(mockutil.ShowPicker, mock.Anything, mock.Anything, mock.Anything).Return(tt.pickerIndex, tt.pickerOk)
+			tui.On(mockutil.ShowParameterForm, mock.MatchedBy(func(p []model.Parameter) bool { return len(p) == 1 }), mock.Anything, ui.OkButtonSave).
+				Return([]string{" staging "}, true)
+			tui.On(mockutil.ShowParameterForm, snippet.GetParameters(), mock.Anything, mock.Anything).
+				Return([]string{"form-ns", "form-cluster"}, true)
+
+			c := newTestCache()
+			c.PutData(presetsKey, []byte(`{"deploy": {
+				"dev": {"NAMESPACE": "dev-ns", "CLUSTER": "dev-cluster"},
+				"prod": {"NAMESPACE": "prod-ns", "CLUSTER": "prod-cluster"}
+			}}`))
+
+			app := NewApp(
+				WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(c),
+			).(*appImpl)
+
+			values, ok := app.showLookupParameterForm(snippet, ui.OkButtonExecute)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.Equal(t, tt.expectedValues, values)
+			tui.AssertNumberOfCalls(t, mockutil.ShowParameterForm, tt.expectedForms)
+
+			items := tui.Calls[1].Arguments.Get(1).([]picker.Item)
+			assert.Len(t, items, 4)
+			assert.Equal(t, "dev", items[1].Title())
+			assert.Equal(t, "NAMESPACE=dev-ns, CLUSTER=dev-cluster", items[1].Description())
+			assert.Equal(t, "prod", items[2].Title())
+		})
+	}
+
+	t.Run("new preset is saved", func(t *testing.T) {
+		tui := &uiMocks.TUI{}
+		tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+		tui.On(mockutil.ShowPicker, mock.Anything, mock.Anything, mock.Anything).Return(2, true)
+		tui.On(mockutil.ShowParameterForm, mock.MatchedBy(func(p []model.Parameter) bool { return len(p) == 1 }), mock.Anything, ui.OkButtonSave).
+			Return([]string{" staging "}, true)
+		tui.On(mockutil.ShowParameterForm, snippet.GetParameters(), mock.Anything, ui.OkButtonSave).
+			Return([]string{"staging-ns", "staging-cluster"}, true)
+
+		c := newTestCache()
+		c.PutData(presetsKey, []byte(`{"deploy": {"prod": {"NAMESPACE": "prod-ns", "CLUSTER": "prod-cluster"}}}`))
+
+		app := NewApp(
+			WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(c),
+		).(*appImpl)
+
+		values, ok := app.showLookupParameterForm(snippet, ui.OkButtonExecute)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"staging-ns", "staging-cluster"}, values)
+		assert.Equal(t, []model.ParameterValue{
+			{Key: "NAMESPACE", Value: "staging-ns"},
+			{Key: "CLUSTER", Value: "staging-cluster"},
+		}, app.PresetParameterValues("deploy", "staging"))
+	})
+}
+
+func Test_App_Presets_LookupWithoutPresets(t *testing.T) {
+	snippet := testutil.TestSnippet{ID: "deploy", Title: "Deploy", Language: model.LanguageBash, Content: testSnippetContent}
+
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, ui.OkButtonPrint).Return([]string{"foo"}, true)
+
+	app := NewApp(
+		WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(newTestCache()),
+	).(*appImpl)
+
+	values, ok := app.showLookupParameterForm(snippet, ui.OkButtonPrint)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"foo"}, values)
+	tui.AssertNotCalled(t, mockutil.ShowPicker, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_App_Presets_CorruptFile(t *testing.T) {
+	snippet := testutil.TestSnippet{ID: "deploy", Title: "Deploy", Language: model.LanguageBash, Content: testSnippetContent}
+
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, mock.Anything).Return([]string{"foo"}, true)
+
+	c := newTestCache()
+	c.PutData(presetsKey, []byte("{invalid"))
+
+	app := NewApp(
+		WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(c),
+	).(*appImpl)
+
+	// the lookup does not offer any preset
+	values, ok := app.showLookupParameterForm(snippet, ui.OkButtonExecute)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"foo"}, values)
+	tui.AssertNotCalled(t, mockutil.ShowPicker, mock.Anything, mock.Anything, mock.Anything)
+
+	assert.PanicsWithValue(t, ErrPresetNotFound, func() { app.PresetParameterValues("deploy", "prod") })
+
+	// saving a preset replaces the corrupt file
+	ok, _ = app.SaveParameterPreset("deploy", "prod", nil)
+	assert.True(t, ok)
+	assert.Equal(t, []model.ParameterValue{{Key: "VAR1", Value: "foo"}}, app.PresetParameterValues("deploy", "prod"))
+}
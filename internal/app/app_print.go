@@ -7,9 +7,8 @@ import (
 
 func (a *appImpl) LookupAndCreatePrintableSnippet() (bool, string) {
 	if ok, snippet := a.LookupSnippet(); ok {
-		parameters := snippet.GetParameters()
-		if parameterValues, paramOk := a.tui.ShowParameterForm(parameters, nil, ui.OkButtonPrint); paramOk {
-			return true, snippet.Format(parameterValues, formatOptions(a.config.Script))
+		if parameterValues, paramOk := a.showLookupParameterForm(snippet, ui.OkButtonPrint); paramOk {
+			return true, snippet.Format(parameterValues, formatOptions(a.config.Script, snippetLanguage(snippet)))
 		}
 	}
 
@@ -19,7 +18,7 @@ func (a *appImpl) LookupAndCreatePrintableSnippet() (bool, string) {
 func (a *appImpl) LookupSnippetArgs() (bool, string, []model.ParameterValue) {
 	if ok, snippet := a.LookupSnippet(); ok {
 		parameters := snippet.GetParameters()
-		if parameterValues, paramOk := a.tui.ShowParameterForm(parameters, nil, ui.OkButtonPrint); paramOk {
+		if parameterValues, paramOk := a.showLookupParameterForm(snippet, ui.OkButtonPrint); paramOk {
 			return true, snippet.GetID(), matchParameterToValues(parameters, parameterValues)
 		}
 	}
@@ -27,12 +26,16 @@ func (a *appImpl) LookupSnippetArgs() (bool, string, []model.ParameterValue) {
 }
 
 func (a *appImpl) FindSnippetAndPrint(id string, paramValues []model.ParameterValue) (bool, string) {
-	if snippetFound, snippet := a.getSnippet(id); !snippetFound {
+	snippetFound, snippet := a.getSnippet(id)
+	if !snippetFound {
 		panic(ErrSnippetIDNotFound)
-	} else if paramOk, parameters := matchParameters(paramValues, snippet.GetParameters()); paramOk {
-		return true, snippet.Format(parameters, formatOptions(a.config.Script))
-	} else if selectedParams, formOk := a.tui.ShowParameterForm(snippet.GetParameters(), paramValues, ui.OkButtonExecute); formOk {
-		return true, snippet.Format(selectedParams, formatOptions(a.config.Script))
+	}
+
+	paramValues = a.withCommandDefaults(snippet, paramValues)
+	if paramOk, parameters := matchParameters(paramValues, snippet.GetParameters()); paramOk {
+		return true, snippet.Format(parameters, formatOptions(a.config.Script, snippetLanguage(snippet)))
+	} else if selectedParams, formOk := a.showParameterForm(snippet, paramValues, ui.OkButtonExecute); formOk {
+		return true, snippet.Format(selectedParams, formatOptions(a.config.Script, snippetLanguage(snippet)))
 	}
 	return false, ""
 }
@@ -0,0 +1,120 @@
+package app
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui"
+)
+
+const (
+	recentValuesKey = cache.DataKey("parameter_values.json")
+
+	// maxRecentValues is the number of values remembered per parameter of a snippet.
+	maxRecentValues = 5
+)
+
+// recentValues holds the most recently entered values, the most recent one first, per snippet ID and parameter key.
+type recentValues map[string]map[string][]string
+
+// showParameterForm shows the parameter form for the snippet. The values and default values of the parameters are
+// computed by their commands, if any. The parameters are prefilled with the value entered most recently and the
+// previously entered values are suggested. The entered values are remembered if the form is submitted.
+func (a *appImpl) showParameterForm(snippet model.Snippet, values []model.ParameterValue, okButton ui.OkButton) ([]string, bool) {
+	recent := a.loadRecentValues()
+	parameters := snippet.GetParameters()
+
+	formParameters := recent.apply(snippet.GetID(), a.resolveParameterCommands(parameters))
+	result, ok := a.tui.ShowParameterForm(formParameters, values, okButton)
+	if ok {
+		a.rememberValues(recent, snippet.GetID(), parameters, result)
+	}
+	return result, ok
+}
+
+// rememberValues stores the values of all parameters except passwords. A failure to store the values is logged only
+// since it must not affect the execution or printing of the snippet.
+func (a *appImpl) rememberValues(recent recentValues, snippetID string, parameters []model.Parameter, values []string) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Warn().Msgf("Failed to remember parameter values: %v", err)
+		}
+	}()
+
+	changed := false
+	for i, parameter := range parameters {
+		if i >= len(values) || values[i] == "" || parameter.Type == model.ParameterTypePassword {
+			continue
+		}
+		recent.add(snippetID, parameter.Key, values[i])
+		changed = true
+	}
+
+	if !changed {
+		return
+	}
+
+	data, err := json.Marshal(recent)
+	if err != nil {
+		panic(err)
+	}
+	a.cache.PutData(recentValuesKey, data)
+}
+
+func (a *appImpl) loadRecentValues() recentValues {
+	result := recentValues{}
+	if data, ok := a.cache.GetData(recentValuesKey); ok {
+		if err := json.Unmarshal(data, &result); err != nil {
+			log.Warn().Err(err).Msg("Ignoring invalid recent parameter values")
+			return recentValues{}
+		}
+	}
+	return result
+}
+
+// add puts the value in front of the recent values of the parameter and drops the oldest values if there are more
+// than maxRecentValues.
+func (r recentValues) add(snippetID, key, value string) {
+	if r[snippetID] == nil {
+		r[snippetID] = map[string][]string{}
+	}
+
+	values := []string{value}
+	for _, v := range r[snippetID][key] {
+		if v != value && len(values) < maxRecentValues {
+			values = append(values, v)
+		}
+	}
+	r[snippetID][key] = values
+}
+
+// apply returns the parameters with the most recent value as default value and all recent values as first options.
+// Parameters without recent values are returned unchanged.
+func (r recentValues) apply(snippetID string, parameters []model.Parameter) []model.Parameter {
+	snippetValues, ok := r[snippetID]
+	if !ok {
+		return parameters
+	}
+
+	result := make([]model.Parameter, len(parameters))
+	for i, parameter := range parameters {
+		result[i] = parameter
+		values := snippetValues[parameter.Key]
+		if len(values) == 0 || parameter.Type == model.ParameterTypePassword {
+			continue
+		}
+
+		result[i].DefaultValue = values[0]
+		result[i].Values = append([]string{}, values...)
+		for _, v := range parameter.Values {
+			if !slices.Contains(values, v) {
+				result[i].Values = append(result[i].Values, v)
+			}
+		}
+	}
+	return result
+}
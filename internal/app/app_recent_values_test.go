@@ -0,0 +1,75 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lemoony/snipkit/internal/config/configtest"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
+	uiMocks "github.com/lemoony/snipkit/mocks/ui"
+)
+
+func Test_App_RecentValues(t *testing.T) {
+	snippet := testutil.TestSnippet{
+		ID:       "deploy",
+		Title:    "Deploy",
+		Language: model.LanguageBash,
+		Content: `# ${NAMESPACE} Values: default, kube-system
+# ${TOKEN} Type: PASSWORD
+echo "${NAMESPACE}"`,
+	}
+
+	tui := &uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.Print, mock.Anything).Return()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, mock.Anything).Return([]string{"staging", "secret"}, true).Once()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, mock.Anything).Return([]string{"default", "secret"}, true).Once()
+	tui.On(mockutil.ShowParameterForm, mock.Anything, mock.Anything, mock.Anything).Return([]string{}, false).Once()
+
+	c := newTestCache()
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManagerSnippets([]model.Snippet{snippet}), withCache(c))
+
+	ok, _ := app.FindSnippetAndPrint("deploy", nil)
+	assert.True(t, ok)
+	ok, _ = app.FindSnippetAndPrint("deploy", nil)
+	assert.True(t, ok)
+	ok, _ = app.FindSnippetAndPrint("deploy", nil)
+	assert.False(t, ok)
+
+	parameters := tui.Calls[len(tui.Calls)-1].Arguments.Get(0).([]model.Parameter)
+	assert.Equal(t, "default", parameters[0].DefaultValue)
+	assert.Equal(t, []string{"default", "staging", "kube-system"}, parameters[0].Values)
+	assert.Empty(t, parameters[1].DefaultValue)
+
+	data, _ := c.GetData(recentValuesKey)
+	assert.NotContains(t, string(data), "secret")
+
+	// the snippet itself is not changed
+	assert.Equal(t, []string{"default", "kube-system"}, snippet.GetParameters()[0].Values)
+	tui.AssertCalled(t, mockutil.ShowParameterForm, snippet.GetParameters(), []model.ParameterValue(nil), ui.OkButtonExecute)
+}
+
+func Test_recentValues_add(t *testing.T) {
+	recent := recentValues{}
+	for i := 0; i < maxRecentValues+2; i++ {
+		recent.add("id", "key", fmt.Sprint(i))
+	}
+	recent.add("id", "key", "4")
+
+	assert.Equal(t, []string{"4", "6", "5", "3", "2"}, recent["id"]["key"])
+}
+
+func Test_recentValues_apply(t *testing.T) {
+	parameters := []model.Parameter{{Key: "A", DefaultValue: "foo"}, {Key: "B", DefaultValue: "bar"}}
+
+	assert.Equal(t, parameters, recentValues{}.apply("id", parameters))
+
+	result := recentValues{"id": {"B": {"baz"}}}.apply("id", parameters)
+	assert.Equal(t, []model.Parameter{{Key: "A", DefaultValue: "foo"}, {Key: "B", DefaultValue: "baz", Values: []string{"baz"}}}, result)
+}
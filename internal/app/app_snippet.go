@@ -0,0 +1,228 @@
+package app
+
+import (
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/managers"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui/picker"
+	"github.com/lemoony/snipkit/internal/ui/uimsg"
+)
+
+var ErrNoWritableManager = errors.New("No enabled snippet manager supports creating or modifying snippets.")
+
+var ErrSnippetNotWritable = errors.New("The manager of the snippet does not support modifying snippets.")
+
+const (
+	snippetActionCreated = "created"
+	snippetActionUpdated = "updated"
+	snippetActionDeleted = "deleted"
+	snippetActionRenamed = "renamed"
+)
+
+func (a *appImpl) CreateSnippet(managerKey model.ManagerKey, draft model.SnippetDraft) {
+	writer, ok := a.selectSnippetWriter(managerKey)
+	if !ok {
+		return
+	}
+
+	if draft.Content == "" {
+		content, changed := a.editSnippetContent("", draft.Language)
+		if !changed || strings.TrimSpace(content) == "" {
+			a.tui.Print(uimsg.SnippetWriteResult(false, snippetActionCreated, draft.Title))
+			return
+		}
+		draft.Content = content
+	}
+
+	id := writer.CreateSnippet(draft)
+	log.Debug().Str("id", id).Str("title", draft.Title).Msg("Snippet created")
+	a.tui.Print(uimsg.SnippetWriteResult(true, snippetActionCreated, draft.Title))
+}
+
+func (a *appImpl) EditSnippet(id string) {
+	writer, snippet, ok := a.findWritableSnippet(id)
+	if !ok {
+		return
+	}
+
+	content, changed := a.editSnippetContent(snippet.GetContent(), snippet.GetLanguage())
+	title := snippet.GetTitle()
+	if !changed {
+		a.tui.Print(uimsg.SnippetWriteResult(false, snippetActionUpdated, title))
+		return
+	}
+
+	writer.UpdateSnippet(snippet.GetID(), model.SnippetDraft{
+		Title:    title,
+		Content:  content,
+		Tags:     snippet.GetTags(),
+		Language: snippet.GetLanguage(),
+	})
+	a.tui.Print(uimsg.SnippetWriteResult(true, snippetActionUpdated, title))
+}
+
+func (a *appImpl) DeleteSnippet(id string, confirmed bool) {
+	writer, snippet, ok := a.findWritableSnippet(id)
+	if !ok {
+		return
+	}
+
+	// the title must be resolved before deletion since some managers read it lazily from the snippet file
+	title := snippet.GetTitle()
+	if !confirmed && !a.tui.Confirmation(uimsg.SnippetDeleteConfirm(title)) {
+		a.tui.Print(uimsg.SnippetWriteResult(false, snippetActionDeleted, title))
+		return
+	}
+
+	writer.DeleteSnippet(snippet.GetID())
+	a.tui.Print(uimsg.SnippetWriteResult(true, snippetActionDeleted, title))
+}
+
+func (a *appImpl) RenameSnippet(id string, title string) {
+	writer, snippet, ok := a.findWritableSnippet(id)
+	if !ok {
+		return
+	}
+
+	writer.RenameSnippet(snippet.GetID(), title)
+	a.tui.Print(uimsg.SnippetWriteResult(true, snippetActionRenamed, title))
+}
+
+// snippetWriters returns all enabled managers which can write snippets with their current configuration.
+func (a *appImpl) snippetWriters() []managers.Manager {
+	var result []managers.Manager
+	for _, manager := range a.managers {
+		if writer, ok := manager.(managers.SnippetWriter); ok && writer.Writable() {
+			result = append(result, manager)
+		}
+	}
+	return result
+}
+
+// selectSnippetWriter returns the manager with the given key. If no key is given and more than one manager can write
+// snippets, the user is asked to pick one.
+func (a *appImpl) selectSnippetWriter(managerKey model.ManagerKey) (managers.SnippetWriter, bool) {
+	writers := a.snippetWriters()
+	if len(writers) == 0 {
+		panic(ErrNoWritableManager)
+	}
+
+	if managerKey != "" {
+		for _, manager := range writers {
+			if manager.Key() == managerKey {
+				return manager.(managers.SnippetWriter), true
+			}
+		}
+		panic(errors.Errorf("The manager %s is not enabled or does not support creating snippets.", managerKey))
+	}
+
+	if len(writers) == 1 {
+		return writers[0].(managers.SnippetWriter), true
+	}
+
+	items := make([]picker.Item, len(writers))
+	for i, manager := range writers {
+		items[i] = picker.NewItem(string(manager.Key()), "")
+	}
+	if index, ok := a.tui.ShowPicker("Which snippet manager should store the snippet?", items, nil); ok {
+		return writers[index].(managers.SnippetWriter), true
+	}
+	return nil, false
+}
+
+// findWritableSnippet returns the snippet with the given ID and its manager. If no ID is given, the user is asked to
+// look up one of the snippets which can be modified.
+func (a *appImpl) findWritableSnippet(id string) (managers.SnippetWriter, model.Snippet, bool) {
+	var snippets []model.Snippet
+	var writers []managers.SnippetWriter
+	for _, manager := range a.snippetWriters() {
+		for _, snippet := range manager.GetSnippets() {
+			snippets = append(snippets, snippet)
+			writers = append(writers, manager.(managers.SnippetWriter))
+		}
+	}
+
+	if id != "" {
+		for i := range snippets {
+			if snippets[i].GetID() == id {
+				return writers[i], snippets[i], true
+			}
+		}
+		if found, _ := a.getSnippet(id); found {
+			panic(ErrSnippetNotWritable)
+		}
+		panic(ErrSnippetIDNotFound)
+	}
+
+	if len(snippets) == 0 {
+		panic(ErrNoSnippetsAvailable)
+	}
+
+	if index := a.tui.ShowLookup(snippets, a.config.FuzzySearch); index >= 0 {
+		return writers[index], snippets[index], true
+	}
+	return nil, nil, false
+}
+
+// editSnippetContent opens the content in the editor of the user. It returns the new content and whether it differs
+// from the initial content.
+func (a *appImpl) editSnippetContent(content string, language model.Language) (string, bool) {
+	file, err := afero.TempFile(a.system.Fs, "", "snipkit-*"+fileSuffixForLanguage(language))
+	if err != nil {
+		panic(errors.Wrap(err, "failed to create temporary file"))
+	}
+	path := file.Name()
+	defer a.system.Remove(path)
+
+	if _, err = file.WriteString(content); err != nil {
+		panic(errors.Wrap(err, "failed to write temporary file"))
+	}
+	if err = file.Close(); err != nil {
+		panic(err)
+	}
+
+	a.tui.OpenEditor(path, a.config.Editor)
+
+	result := string(a.system.ReadFile(path))
+	if !strings.HasSuffix(content, "\n") {
+		// most editors add a final newline which is not part of the original content
+		result = strings.TrimRight(result, "\n")
+	}
+	return result, result != content
+}
+
+func fileSuffixForLanguage(language model.Language) string {
+	switch language {
+	case model.LanguageYAML:
+		return ".yaml"
+	case model.LanguageMarkdown:
+		return ".md"
+	case model.LanguageTOML:
+		return ".toml"
+	case model.LanguageText:
+		return ".txt"
+	case model.LanguagePython:
+		return ".py"
+	case model.LanguageRuby:
+		return ".rb"
+	case model.LanguageJavaScript:
+		return ".js"
+	case model.LanguagePowerShell:
+		return ".ps1"
+	case model.LanguagePerl:
+		return ".pl"
+	case model.LanguageSQL:
+		return ".sql"
+	case model.LanguageDockerfile:
+		return ".dockerfile"
+	case model.LanguageJSON:
+		return ".json"
+	default:
+		return ".sh"
+	}
+}
@@ -0,0 +1,229 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lemoony/snipkit/internal/config/configtest"
+	"github.com/lemoony/snipkit/internal/managers/fslibrary"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui/uimsg"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
+	managerMocks "github.com/lemoony/snipkit/mocks/managers"
+	uiMocks "github.com/lemoony/snipkit/mocks/ui"
+)
+
+func newSnippetTestLibrary(t *testing.T) (*fslibrary.Manager, string) {
+	t.Helper()
+	libraryPath := t.TempDir()
+	manager, err := fslibrary.NewManager(
+		fslibrary.WithSystem(system.NewSystem()),
+		fslibrary.WithConfig(fslibrary.Config{Enabled: true, LibraryPath: []string{libraryPath}, SuffixRegex: []string{".sh"}}),
+	)
+	assert.NoError(t, err)
+	return manager, libraryPath
+}
+
+func newSnippetTestTUI() *uiMocks.TUI {
+	tui := uiMocks.TUI{}
+	tui.On(mockutil.ApplyConfig, mock.Anything, mock.Anything).Return()
+	tui.On(mockutil.Print, mock.Anything).Return()
+	return &tui
+}
+
+func mockEditorContent(tui *uiMocks.TUI, content string) {
+	tui.On(mockutil.OpenEditor, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		_ = os.WriteFile(args.Get(0).(string), []byte(content), 0o600)
+	}).Return()
+}
+
+func Test_App_CreateSnippet(t *testing.T) {
+	manager, libraryPath := newSnippetTestLibrary(t)
+	tui := newSnippetTestTUI()
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+	app.CreateSnippet("", model.SnippetDraft{Title: "Say hello", Content: "echo hello"})
+
+	contents, err := os.ReadFile(filepath.Join(libraryPath, "say-hello.sh"))
+	assert.NoError(t, err)
+	assert.Equal(t, "#\n# Say hello\n#\n\necho hello", string(contents))
+	tui.AssertCalled(t, mockutil.Print, uimsg.SnippetWriteResult(true, snippetActionCreated, "Say hello"))
+	tui.AssertNotCalled(t, mockutil.OpenEditor, mock.Anything, mock.Anything)
+}
+
+func Test_App_CreateSnippet_WithEditor(t *testing.T) {
+	manager, libraryPath := newSnippetTestLibrary(t)
+	tui := newSnippetTestTUI()
+	mockEditorContent(tui, "echo edited\n")
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+	app.CreateSnippet(manager.Key(), model.SnippetDraft{Title: "Edited"})
+
+	contents, err := os.ReadFile(filepath.Join(libraryPath, "edited.sh"))
+	assert.NoError(t, err)
+	assert.Equal(t, "#\n# Edited\n#\n\necho edited", string(contents))
+}
+
+func Test_App_CreateSnippet_EmptyEditorContent(t *testing.T) {
+	manager, libraryPath := newSnippetTestLibrary(t)
+	tui := newSnippetTestTUI()
+	mockEditorContent(tui, "\n")
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+	app.CreateSnippet("", model.SnippetDraft{Title: "Empty"})
+
+	entries, err := os.ReadDir(libraryPath)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+	tui.AssertCalled(t, mockutil.Print, uimsg.SnippetWriteResult(false, snippetActionCreated, "Empty"))
+}
+
+func Test_App_CreateSnippet_NoWritableManager(t *testing.T) {
+	manager := managerMocks.Manager{}
+	tui := newSnippetTestTUI()
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(&manager))
+	assert.PanicsWithValue(t, ErrNoWritableManager, func() {
+		app.CreateSnippet("", model.SnippetDraft{Title: "foo", Content: "echo foo"})
+	})
+}
+
+func Test_App_CreateSnippet_PickManager(t *testing.T) {
+	manager1, libraryPath1 := newSnippetTestLibrary(t)
+	manager2, libraryPath2 := newSnippetTestLibrary(t)
+	tui := newSnippetTestTUI()
+	tui.On(mockutil.ShowPicker, mock.Anything, mock.Anything, mock.Anything).Return(1, true)
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager1, manager2))
+	app.CreateSnippet("", model.SnippetDraft{Title: "foo", Content: "echo foo"})
+
+	assert.NoFileExists(t, filepath.Join(libraryPath1, "foo.sh"))
+	assert.FileExists(t, filepath.Join(libraryPath2, "foo.sh"))
+}
+
+func Test_App_EditSnippet(t *testing.T) {
+	tests := []struct {
+		name           string
+		editedContent  string
+		expectModified bool
+	}{
+		{name: "changed", editedContent: "echo bar\n", expectModified: true},
+		{name: "unchanged", editedContent: "echo foo\n", expectModified: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, libraryPath := newSnippetTestLibrary(t)
+			filePath := filepath.Join(libraryPath, "foo.sh")
+			assert.NoError(t, os.WriteFile(filePath, []byte("echo foo"), 0o600))
+
+			tui := newSnippetTestTUI()
+			mockEditorContent(tui, tt.editedContent)
+
+			app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+			app.EditSnippet(idutil.FormatSnippetID(filePath, "fsl"))
+
+			contents, err := os.ReadFile(filePath)
+			assert.NoError(t, err)
+			if tt.expectModified {
+				assert.Equal(t, "echo bar", string(contents))
+			} else {
+				assert.Equal(t, "echo foo", string(contents))
+			}
+			tui.AssertCalled(t, mockutil.Print, uimsg.SnippetWriteResult(tt.expectModified, snippetActionUpdated, "foo.sh"))
+		})
+	}
+}
+
+func Test_App_EditSnippet_Lookup(t *testing.T) {
+	manager, libraryPath := newSnippetTestLibrary(t)
+	filePath := filepath.Join(libraryPath, "foo.sh")
+	assert.NoError(t, os.WriteFile(filePath, []byte("echo foo"), 0o600))
+
+	tui := newSnippetTestTUI()
+	tui.On("ShowLookup", mock.Anything, mock.Anything).Return(0)
+	mockEditorContent(tui, "echo bar")
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+	app.EditSnippet("")
+
+	contents, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo bar", string(contents))
+}
+
+func Test_App_EditSnippet_NotWritable(t *testing.T) {
+	manager := managerMocks.Manager{}
+	manager.On("GetSnippets").Return([]model.Snippet{testutil.TestSnippet{ID: "foo", Title: "foo", Content: "echo foo"}})
+	tui := newSnippetTestTUI()
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(&manager))
+	assert.PanicsWithValue(t, ErrSnippetNotWritable, func() {
+		app.EditSnippet("foo")
+	})
+	assert.PanicsWithValue(t, ErrSnippetIDNotFound, func() {
+		app.EditSnippet("bar")
+	})
+}
+
+func Test_App_DeleteSnippet(t *testing.T) {
+	tests := []struct {
+		name      string
+		confirmed bool
+		answer    bool
+		deleted   bool
+	}{
+		{name: "confirmed via flag", confirmed: true, deleted: true},
+		{name: "confirmed by user", answer: true, deleted: true},
+		{name: "declined by user", answer: false, deleted: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, libraryPath := newSnippetTestLibrary(t)
+			filePath := filepath.Join(libraryPath, "foo.sh")
+			assert.NoError(t, os.WriteFile(filePath, []byte("echo foo"), 0o600))
+
+			tui := newSnippetTestTUI()
+			tui.On(mockutil.Confirmation, mock.Anything).Return(tt.answer)
+
+			app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+			app.DeleteSnippet(idutil.FormatSnippetID(filePath, "fsl"), tt.confirmed)
+
+			if tt.deleted {
+				assert.NoFileExists(t, filePath)
+			} else {
+				assert.FileExists(t, filePath)
+			}
+			if tt.confirmed {
+				tui.AssertNotCalled(t, mockutil.Confirmation, mock.Anything)
+			} else {
+				tui.AssertCalled(t, mockutil.Confirmation, uimsg.SnippetDeleteConfirm("foo.sh"))
+			}
+			tui.AssertCalled(t, mockutil.Print, uimsg.SnippetWriteResult(tt.deleted, snippetActionDeleted, "foo.sh"))
+		})
+	}
+}
+
+func Test_App_RenameSnippet(t *testing.T) {
+	manager, libraryPath := newSnippetTestLibrary(t)
+	filePath := filepath.Join(libraryPath, "foo.sh")
+	assert.NoError(t, os.WriteFile(filePath, []byte("#\n# Foo\n#\necho foo"), 0o600))
+
+	tui := newSnippetTestTUI()
+
+	app := NewApp(WithTUI(tui), WithConfig(configtest.NewTestConfig().Config), withManager(manager))
+	app.RenameSnippet(idutil.FormatSnippetID(filePath, "fsl"), "Bar")
+
+	contents, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "#\n# Bar\n#\n\necho foo", string(contents))
+	tui.AssertCalled(t, mockutil.Print, uimsg.SnippetWriteResult(true, snippetActionRenamed, "Bar"))
+}
@@ -3,7 +3,9 @@ package app
 import (
 	"os"
 	"path"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,7 @@ import (
 	"github.com/lemoony/snipkit/internal/config"
 	"github.com/lemoony/snipkit/internal/managers"
 	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui/uimsg"
 	"github.com/lemoony/snipkit/internal/utils/assertutil"
 	"github.com/lemoony/snipkit/internal/utils/testutil"
 	"github.com/lemoony/snipkit/internal/utils/testutil/mockutil"
@@ -113,3 +116,59 @@ func Test_appImpl_GetAllSnippets(t *testing.T) {
 	s := app.getAllSnippets()
 	assertutil.AssertSnippetsEqual(t, snippets, s)
 }
+
+type prioritizingManager struct {
+	*managerMocks.Manager
+	prioritizedIDs []string
+}
+
+func (m prioritizingManager) IsPrioritized(snippet model.Snippet) bool {
+	return slices.Contains(m.prioritizedIDs, snippet.GetID())
+}
+
+func Test_appImpl_GetAllSnippets_prioritized(t *testing.T) {
+	globalSnippet := testutil.TestSnippet{ID: "global", Title: "global", Language: model.LanguageBash, Tags: []string{}}
+	taskSnippet := testutil.TestSnippet{ID: "task-1", Title: "task-1", Language: model.LanguageBash, Tags: []string{}}
+	otherTaskSnippet := testutil.TestSnippet{ID: "task-2", Title: "task-2", Language: model.LanguageBash, Tags: []string{}}
+
+	globalManager := managerMocks.Manager{}
+	globalManager.On("GetSnippets").Return([]model.Snippet{globalSnippet}, nil)
+
+	taskManager := managerMocks.Manager{}
+	taskManager.On("GetSnippets").Return([]model.Snippet{otherTaskSnippet, taskSnippet}, nil)
+
+	app := appImpl{managers: []managers.Manager{
+		&globalManager,
+		prioritizingManager{Manager: &taskManager, prioritizedIDs: []string{"task-1"}},
+	}}
+
+	s := app.getAllSnippets()
+	assertutil.AssertSnippetsEqual(t, []model.Snippet{taskSnippet, globalSnippet, otherTaskSnippet}, s)
+}
+
+func Test_appImpl_GetAllSnippets_failingManagers(t *testing.T) {
+	snippet := testutil.TestSnippet{ID: "uuid1", Title: "title-1", Language: model.LanguageBash, Tags: []string{}}
+
+	workingManager := managerMocks.Manager{}
+	workingManager.On("GetSnippets").Return([]model.Snippet{snippet}, nil)
+
+	failingManager := managerMocks.Manager{}
+	failingManager.On("Key").Return(model.ManagerKey("failing"))
+	failingManager.On("GetSnippets").Panic("file is corrupted")
+
+	slowManager := managerMocks.Manager{}
+	slowManager.On("Key").Return(model.ManagerKey("slow"))
+	slowManager.On("GetSnippets").After(time.Second).Return([]model.Snippet{snippet}, nil)
+
+	tui := uiMocks.TUI{}
+	tui.On(mockutil.Print, mock.Anything).Return()
+
+	cfg := config.Config{ManagerTimeout: 50 * time.Millisecond}
+	app := appImpl{config: &cfg, tui: &tui, managers: []managers.Manager{&failingManager, &workingManager, &slowManager}}
+
+	s := app.getAllSnippets()
+	assertutil.AssertSnippetsEqual(t, []model.Snippet{snippet}, s)
+
+	tui.AssertCalled(t, mockutil.Print, uimsg.ManagerLoadFailed("failing", "file is corrupted"))
+	tui.AssertCalled(t, mockutil.Print, uimsg.ManagerLoadFailed("slow", "loading snippets took longer than 50ms"))
+}
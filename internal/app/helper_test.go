@@ -1,10 +1,16 @@
 package app
 
 import (
+	"os"
+	"testing"
+
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/lemoony/snipkit/internal/cache"
 	"github.com/lemoony/snipkit/internal/managers"
 	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/system"
 	managerMocks "github.com/lemoony/snipkit/mocks/managers"
 )
 
@@ -12,6 +18,31 @@ var testSnippetContent = `# ${VAR1} Name: First Output
 # ${VAR1} Description: What to print on the tui first
 echo "${VAR1}`
 
+// TestMain points the SnipKit home directory to a temporary directory so that executed snippets are not recorded in
+// the history of the user.
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "snipkit-app-test-*")
+	if err != nil {
+		panic(err)
+	}
+	_ = os.Setenv("SNIPKIT_HOME", home)
+
+	code := m.Run()
+	_ = os.RemoveAll(home)
+	os.Exit(code)
+}
+
+// newTestCache returns a cache which is backed by memory only so that tests do not affect each other.
+func newTestCache() cache.Cache {
+	return cache.New(system.NewSystem(system.WithFS(afero.NewMemMapFs())))
+}
+
+func withCache(c cache.Cache) Option {
+	return optionFunc(func(a *appImpl) {
+		a.cache = c
+	})
+}
+
 func withManagerSnippets(snippets []model.Snippet) Option {
 	return optionFunc(func(a *appImpl) {
 		manager := managerMocks.Manager{}
@@ -9,8 +9,9 @@ import (
 type SaveMode string
 
 const (
-	SaveModeNever     = SaveMode("NEVER")
-	SaveModeFsLibrary = SaveMode("FS_LIBRARY")
+	SaveModeNever      = SaveMode("NEVER")
+	SaveModeFsLibrary  = SaveMode("FS_LIBRARY")
+	SaveModeGitHubGist = SaveMode("GITHUB_GIST")
 )
 
 var (
@@ -31,7 +32,7 @@ type ProviderConfig struct {
 
 // Config is the top-level assistant configuration.
 type Config struct {
-	SaveMode  SaveMode         `yaml:"saveMode" mapstructure:"saveMode" head_comment:"Defines if you want to save the snippets created by the assistant. Possible values: NEVER | FS_LIBRARY"`
+	SaveMode  SaveMode         `yaml:"saveMode" mapstructure:"saveMode" head_comment:"Defines if you want to save the snippets created by the assistant. Possible values: NEVER | FS_LIBRARY | GITHUB_GIST"`
 	Providers []ProviderConfig `yaml:"providers,omitempty" mapstructure:"providers" head_comment:"List of LLM providers. The first enabled provider will be used."`
 }
 
@@ -11,6 +11,7 @@ type snippetImpl struct {
 	content   string
 	tags      []string
 	titleFunc func() string
+	metadata  model.SnippetMetadata
 }
 
 func (s snippetImpl) GetID() string {
@@ -40,3 +41,7 @@ func (s snippetImpl) GetParameters() []model.Parameter {
 func (s snippetImpl) Format(values []string, options model.SnippetFormatOptions) string {
 	return parser.CreateSnippet(s.GetContent(), s.GetParameters(), values, options)
 }
+
+func (s snippetImpl) GetMetadata() model.SnippetMetadata {
+	return s.metadata
+}
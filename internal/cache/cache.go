@@ -14,6 +14,7 @@ type Cache interface {
 	PutSecret(key SecretKey, account string, secret string)
 	DeleteSecret(key SecretKey, account string)
 	PutData(key DataKey, data []byte)
+	AppendData(key DataKey, data []byte)
 	GetData(key DataKey) ([]byte, bool)
 	EnablePlainFileSecrets()
 }
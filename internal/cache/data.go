@@ -1,17 +1,38 @@
 package cache
 
 import (
+	"os"
 	"path/filepath"
 
+	"emperror.dev/errors"
 	"github.com/spf13/afero"
 )
 
+const fileModeData = os.FileMode(0o600)
+
 func (c *cacheImpl) PutData(key DataKey, data []byte) {
 	path := c.cacheFilepath(key)
 	c.system.CreatePath(path)
 	c.system.WriteFile(path, data)
 }
 
+// AppendData appends the data to the data stored for the key so that existing data does not need to be read and
+// written again, e.g., for append-only logs.
+func (c *cacheImpl) AppendData(key DataKey, data []byte) {
+	path := c.cacheFilepath(key)
+	c.system.CreatePath(path)
+
+	file, err := c.system.Fs.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, fileModeData)
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to open %s", path))
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err = file.Write(data); err != nil {
+		panic(errors.Wrapf(err, "failed to append to %s", path))
+	}
+}
+
 func (c *cacheImpl) GetData(key DataKey) ([]byte, bool) {
 	bytes, err := afero.ReadFile(c.system.Fs, c.cacheFilepath(key))
 	if err != nil {
@@ -23,3 +23,16 @@ func Test_PutAndGetData(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, []byte("foo"), data)
 }
+
+func Test_AppendData(t *testing.T) {
+	cache := New(testutil.NewTestSystem())
+
+	const testKey = DataKey("test_key_2")
+
+	cache.AppendData(testKey, []byte("foo\n"))
+	cache.AppendData(testKey, []byte("bar\n"))
+
+	data, ok := cache.GetData(testKey)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("foo\nbar\n"), data)
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/lemoony/snipkit/internal/assistant"
 	"github.com/lemoony/snipkit/internal/managers"
 	"github.com/lemoony/snipkit/internal/ui"
@@ -32,13 +34,16 @@ type Config struct {
 	SecretStorage      SecretStorage    `yaml:"secretStorage" mapstructure:"secretStorage" head_comment:"How secrets like access tokens are stored (see https://lemoony.github.io/snipkit/latest/configuration/overview/#secret-storage)."`
 	Script             ScriptConfig     `yaml:"scripts" mapstructure:"scripts" head_comment:"Options regarding script handling"`
 	Assistant          assistant.Config `yaml:"assistant" mapstructure:"assistant" head_comment:"Configure an AI assistant"`
+	ManagerTimeout     time.Duration    `yaml:"managerTimeout,omitempty" mapstructure:"managerTimeout" head_comment:"Maximum time to load the snippets of a single manager, e.g., 10s. If a manager takes longer, its snippets are skipped." line_comment:"Defaults to 10s when empty."`
 	Manager            managers.Config  `yaml:"manager" mapstructure:"manager"`
 }
 
 type ScriptConfig struct {
-	Shell          string        `yaml:"shell" mapstructure:"shell" head_comment:"The path to the shell to execute scripts with. If not set or empty, $SHELL will be used instead. Fallback is '/bin/bash'."`
-	ParameterMode  ParameterMode `yaml:"parameterMode" mapstructure:"parameterMode" head_comment:"Defines how parameters are handled. Allowed values: SET (sets the parameter value as shell variable) and REPLACE (replaces all occurrences of the variable with the actual value)"`
-	RemoveComments bool          `yaml:"removeComments" mapstructure:"removeComments" head_comment:"If set to true, any comments in your scripts will be removed upon executing or printing."`
-	ExecConfirm    bool          `yaml:"execConfirm" mapstructure:"execConfirm" head_comment:"If set to true, the executed command is always printed on stdout before execution for confirmation (same functionality as providing flag -c/--confirm)."`
-	ExecPrint      bool          `yaml:"execPrint" mapstructure:"execPrint" head_comment:"If set to true, the executed command is always printed on stdout (same functionality as providing flag -p/--print)."`
+	Shell             string            `yaml:"shell" mapstructure:"shell" head_comment:"The path to the shell to execute scripts with. If not set or empty, $SHELL will be used instead. Fallback is '/bin/bash'."`
+	ParameterMode     ParameterMode     `yaml:"parameterMode" mapstructure:"parameterMode" head_comment:"Defines how parameters are handled. Allowed values: SET (sets the parameter value as shell variable) and REPLACE (replaces all occurrences of the variable with the actual value)"`
+	RemoveComments    bool              `yaml:"removeComments" mapstructure:"removeComments" head_comment:"If set to true, any comments in your scripts will be removed upon executing or printing."`
+	ExecConfirm       bool              `yaml:"execConfirm" mapstructure:"execConfirm" head_comment:"If set to true, the executed command is always printed on stdout before execution for confirmation (same functionality as providing flag -c/--confirm)."`
+	ExecPrint         bool              `yaml:"execPrint" mapstructure:"execPrint" head_comment:"If set to true, the executed command is always printed on stdout (same functionality as providing flag -p/--print)."`
+	ParameterCommands bool              `yaml:"parameterCommands,omitempty" mapstructure:"parameterCommands" head_comment:"If set to true, the commands of parameters defined via ValuesCommand and DefaultCommand are executed with the shell to compute values. Only enable it if you trust all snippet sources."`
+	Interpreters      map[string]string `yaml:"interpreters,omitempty" mapstructure:"interpreters" head_comment:"Commands to execute snippets of a language with, e.g., python: python3 -c. The script is passed as last argument. An empty command executes the snippets with the shell." line_comment:"Defaults for python, ruby, javascript, powershell and perl are used when not set."`
 }
@@ -186,6 +186,33 @@ func (s *serviceImpl) UpdateManagerConfig(managerConfig managers.Config) {
 	if cfg := managerConfig.GithubGist; cfg != nil {
 		config.Manager.GithubGist = cfg
 	}
+	if cfg := managerConfig.GitRepository; cfg != nil {
+		config.Manager.GitRepository = cfg
+	}
+	if cfg := managerConfig.GitLab; cfg != nil {
+		config.Manager.GitLab = cfg
+	}
+	if cfg := managerConfig.Navi; cfg != nil {
+		config.Manager.Navi = cfg
+	}
+	if cfg := managerConfig.Tldr; cfg != nil {
+		config.Manager.Tldr = cfg
+	}
+	if cfg := managerConfig.ShellHistory; cfg != nil {
+		config.Manager.ShellHistory = cfg
+	}
+	if cfg := managerConfig.Cheat; cfg != nil {
+		config.Manager.Cheat = cfg
+	}
+	if cfg := managerConfig.VSCode; cfg != nil {
+		config.Manager.VSCode = cfg
+	}
+	if cfg := managerConfig.MarkdownNotebook; cfg != nil {
+		config.Manager.MarkdownNotebook = cfg
+	}
+	if cfg := managerConfig.ProjectTasks; cfg != nil {
+		config.Manager.ProjectTasks = cfg
+	}
 
 	bytes := SerializeToYamlWithComment(wrap(config))
 	s.system.WriteFile(s.ConfigFilePath(), bytes)
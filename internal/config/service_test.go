@@ -15,10 +15,19 @@ import (
 	"github.com/lemoony/snipkit/internal/assistant"
 	"github.com/lemoony/snipkit/internal/config/testdata"
 	"github.com/lemoony/snipkit/internal/managers"
+	"github.com/lemoony/snipkit/internal/managers/cheat"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
+	"github.com/lemoony/snipkit/internal/managers/gitlab"
+	"github.com/lemoony/snipkit/internal/managers/gitrepo"
+	"github.com/lemoony/snipkit/internal/managers/navi"
+	"github.com/lemoony/snipkit/internal/managers/notebook"
 	"github.com/lemoony/snipkit/internal/managers/pet"
 	"github.com/lemoony/snipkit/internal/managers/pictarinesnip"
+	"github.com/lemoony/snipkit/internal/managers/shellhistory"
 	"github.com/lemoony/snipkit/internal/managers/snippetslab"
+	"github.com/lemoony/snipkit/internal/managers/tasks"
+	"github.com/lemoony/snipkit/internal/managers/tldr"
+	"github.com/lemoony/snipkit/internal/managers/vscode"
 	"github.com/lemoony/snipkit/internal/ui/uimsg"
 	"github.com/lemoony/snipkit/internal/utils/assertutil"
 	"github.com/lemoony/snipkit/internal/utils/system"
@@ -351,6 +360,42 @@ func Test_UpdateManagerConfig(t *testing.T) {
 				assert.True(t, cfg.Manager.FsLibrary.Enabled)
 			},
 		},
+		{
+			name: "gitrepo", update: managers.Config{GitRepository: &gitrepo.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.GitRepository.Enabled) },
+		},
+		{
+			name: "gitlab", update: managers.Config{GitLab: &gitlab.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.GitLab.Enabled) },
+		},
+		{
+			name: "navi", update: managers.Config{Navi: &navi.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.Navi.Enabled) },
+		},
+		{
+			name: "tldr", update: managers.Config{Tldr: &tldr.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.Tldr.Enabled) },
+		},
+		{
+			name: "shellhistory", update: managers.Config{ShellHistory: &shellhistory.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.ShellHistory.Enabled) },
+		},
+		{
+			name: "cheat", update: managers.Config{Cheat: &cheat.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.Cheat.Enabled) },
+		},
+		{
+			name: "vscode", update: managers.Config{VSCode: &vscode.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.VSCode.Enabled) },
+		},
+		{
+			name: "notebook", update: managers.Config{MarkdownNotebook: &notebook.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.MarkdownNotebook.Enabled) },
+		},
+		{
+			name: "tasks", update: managers.Config{ProjectTasks: &tasks.Config{Enabled: true}},
+			assert: func(cfg Config) { assert.True(t, cfg.Manager.ProjectTasks.Enabled) },
+		},
 	}
 
 	for i := range tests {
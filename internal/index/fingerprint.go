@@ -0,0 +1,28 @@
+package index
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// FileFingerprint returns a fingerprint based on the modification time and size of the files. Files which do not
+// exist are part of the fingerprint as well. If any file cannot be accessed, an empty fingerprint is returned so that
+// the source is not indexed.
+func FileFingerprint(fs afero.Fs, paths ...string) string {
+	parts := make([]string, len(paths))
+	for i, path := range paths {
+		info, err := fs.Stat(path)
+		switch {
+		case os.IsNotExist(err):
+			parts[i] = "-"
+		case err != nil:
+			return ""
+		default:
+			parts[i] = fmt.Sprintf("%d:%d", info.ModTime().UnixNano(), info.Size())
+		}
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,193 @@
+package index
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/parser"
+)
+
+const (
+	dataKeyPrefix = "snippet_index_"
+
+	// storeVersion must be increased whenever the stored entries change or a manager parses its sources differently,
+	// e.g., because the language detection or the parameter syntax changed. Otherwise, outdated snippets are served
+	// from the index until their sources are modified.
+	storeVersion = "1.2"
+)
+
+// FormatFunc formats the content of an indexed snippet with the given parameter values.
+type FormatFunc func(content string, parameters []model.Parameter, values []string, options model.SnippetFormatOptions) string
+
+// Index persists the parsed snippets of a manager per source, e.g., a file. The snippets of a source are only parsed
+// again if the fingerprint of the source changed. A nil index parses all sources.
+type Index struct {
+	cache    cache.Cache
+	key      cache.DataKey
+	format   FormatFunc
+	store    store
+	visited  map[string]source
+	modified bool
+}
+
+type store struct {
+	Version string            `json:"version"`
+	Config  string            `json:"config"`
+	Sources map[string]source `json:"sources"`
+}
+
+type source struct {
+	Fingerprint string  `json:"fingerprint"`
+	Entries     []entry `json:"entries"`
+}
+
+type entry struct {
+	ID         string                `json:"id"`
+	Title      string                `json:"title"`
+	Content    string                `json:"content"`
+	Tags       []string              `json:"tags"`
+	Language   model.Language        `json:"language"`
+	Parameters []model.Parameter     `json:"parameters"`
+	Metadata   model.SnippetMetadata `json:"metadata"`
+}
+
+// Option configures an Index.
+type Option interface {
+	apply(i *Index)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(i *Index)
+
+func (f optionFunc) apply(i *Index) {
+	f(i)
+}
+
+// WithFormatFunc sets the func to format indexed snippets. By default, snippets are formatted via
+// parser.CreateSnippet.
+func WithFormatFunc(format FormatFunc) Option {
+	return optionFunc(func(i *Index) {
+		i.format = format
+	})
+}
+
+// Load returns the index of the manager stored in the cache. All sources are invalidated if the config differs from
+// the config the index was built with. If no cache is provided, nil is returned.
+func Load(c cache.Cache, manager model.ManagerKey, config any, options ...Option) *Index {
+	if c == nil {
+		return nil
+	}
+
+	index := &Index{
+		cache:   c,
+		key:     cache.DataKey(dataKeyPrefix + string(manager)),
+		format:  parser.CreateSnippet,
+		visited: map[string]source{},
+	}
+	for _, o := range options {
+		o.apply(index)
+	}
+
+	if raw, ok := c.GetData(index.key); ok {
+		if err := json.Unmarshal(raw, &index.store); err != nil {
+			log.Warn().Err(err).Str("manager", string(manager)).Msg("snippet index invalid")
+		}
+	}
+
+	if configHash := hashConfig(config); index.store.Version != storeVersion || index.store.Config != configHash {
+		index.store = store{Version: storeVersion, Config: configHash}
+	}
+
+	return index
+}
+
+// Snippets returns the snippets of the source. If the fingerprint equals the fingerprint of the indexed source, the
+// indexed snippets are returned. Otherwise, the source is parsed and indexed again.
+func (i *Index) Snippets(sourceKey string, fingerprint string, parse func() []model.Snippet) []model.Snippet {
+	if snippets, ok := i.Lookup(sourceKey, fingerprint); ok {
+		return snippets
+	}
+	snippets := parse()
+	i.Put(sourceKey, fingerprint, snippets)
+	return snippets
+}
+
+// Lookup returns the indexed snippets of the source if its fingerprint is unchanged.
+func (i *Index) Lookup(sourceKey string, fingerprint string) ([]model.Snippet, bool) {
+	if i == nil || fingerprint == "" {
+		return nil, false
+	}
+
+	indexed, ok := i.store.Sources[sourceKey]
+	if !ok || indexed.Fingerprint != fingerprint {
+		return nil, false
+	}
+
+	i.visited[sourceKey] = indexed
+	result := make([]model.Snippet, len(indexed.Entries))
+	for j := range indexed.Entries {
+		result[j] = snippet{entry: indexed.Entries[j], format: i.format}
+	}
+	return result, true
+}
+
+// Put indexes the parsed snippets of the source. Sources without fingerprint are not indexed.
+func (i *Index) Put(sourceKey string, fingerprint string, snippets []model.Snippet) {
+	if i == nil || fingerprint == "" {
+		return
+	}
+
+	log.Trace().Str("source", sourceKey).Int("snippets", len(snippets)).Msg("Indexing snippets")
+
+	entries := make([]entry, len(snippets))
+	for j, s := range snippets {
+		entries[j] = entry{
+			ID:         s.GetID(),
+			Title:      s.GetTitle(),
+			Content:    s.GetContent(),
+			Tags:       s.GetTags(),
+			Language:   s.GetLanguage(),
+			Parameters: s.GetParameters(),
+			Metadata:   s.GetMetadata(),
+		}
+	}
+
+	i.visited[sourceKey] = source{Fingerprint: fingerprint, Entries: entries}
+	i.modified = true
+}
+
+// Save stores the index in the cache if any source was parsed or removed. Sources which have not been requested since
+// the index was loaded are removed.
+func (i *Index) Save() {
+	if i == nil {
+		return
+	}
+
+	if !i.modified && len(i.visited) == len(i.store.Sources) {
+		return
+	}
+
+	i.store.Sources = i.visited
+	if bytes, err := json.Marshal(i.store); err != nil {
+		panic(err)
+	} else {
+		i.cache.PutData(i.key, bytes)
+	}
+
+	i.modified = false
+}
+
+func hashConfig(config any) string {
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to hash config"))
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,176 @@
+package index
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	mocks "github.com/lemoony/snipkit/mocks/cache"
+)
+
+const testManagerKey = model.ManagerKey("test")
+
+type testConfig struct {
+	Paths []string
+}
+
+// newTestCache returns a cache mock which keeps the data stored via PutData.
+func newTestCache() (*mocks.Cache, *[]byte) {
+	var data []byte
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", cache.DataKey("snippet_index_test")).Return(func(cache.DataKey) []byte {
+		return data
+	}, func(cache.DataKey) bool {
+		return data != nil
+	})
+	cacheMock.On("PutData", cache.DataKey("snippet_index_test"), mock.Anything).Run(func(args mock.Arguments) {
+		data = args.Get(1).([]byte)
+	}).Return()
+	return &cacheMock, &data
+}
+
+func parseFunc(counter *int, snippets ...model.Snippet) func() []model.Snippet {
+	return func() []model.Snippet {
+		*counter++
+		return snippets
+	}
+}
+
+func Test_Index(t *testing.T) {
+	cacheMock, _ := newTestCache()
+	config := testConfig{Paths: []string{"/foo"}}
+	snippet := testutil.TestSnippet{
+		ID:       "id-1",
+		Title:    "title",
+		Content:  "# ${VAR} Name: Variable\necho ${VAR}",
+		Tags:     []string{"tag"},
+		Language: model.LanguageBash,
+	}
+
+	parsed := 0
+
+	index := Load(cacheMock, testManagerKey, config)
+	assert.Equal(t, []model.Snippet{snippet}, index.Snippets("file-1", "fp-1", parseFunc(&parsed, snippet)))
+	index.Save()
+	assert.Equal(t, 1, parsed)
+
+	index = Load(cacheMock, testManagerKey, config)
+	snippets := index.Snippets("file-1", "fp-1", parseFunc(&parsed, snippet))
+	assert.Equal(t, 1, parsed)
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "id-1", snippets[0].GetID())
+	assert.Equal(t, "title", snippets[0].GetTitle())
+	assert.Equal(t, snippet.Content, snippets[0].GetContent())
+	assert.Equal(t, []string{"tag"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+	assert.Len(t, snippets[0].GetParameters(), 1)
+	assert.Equal(t, snippet.GetParameters(), snippets[0].GetParameters())
+	assert.Equal(t, snippet.Format([]string{"foo"}, model.SnippetFormatOptions{}), snippets[0].Format([]string{"foo"}, model.SnippetFormatOptions{}))
+
+	// modified source is parsed again
+	index = Load(cacheMock, testManagerKey, config)
+	index.Snippets("file-1", "fp-2", parseFunc(&parsed, snippet))
+	index.Save()
+	assert.Equal(t, 2, parsed)
+
+	// modified config invalidates all sources
+	index = Load(cacheMock, testManagerKey, testConfig{Paths: []string{"/bar"}})
+	index.Snippets("file-1", "fp-2", parseFunc(&parsed, snippet))
+	index.Save()
+	assert.Equal(t, 3, parsed)
+
+	// sources without fingerprint are not indexed
+	index = Load(cacheMock, testManagerKey, testConfig{Paths: []string{"/bar"}})
+	index.Snippets("file-2", "", parseFunc(&parsed))
+	index.Snippets("file-2", "", parseFunc(&parsed))
+	assert.Equal(t, 5, parsed)
+}
+
+func Test_Index_OutdatedVersion(t *testing.T) {
+	cacheMock, data := newTestCache()
+	parsed := 0
+
+	index := Load(cacheMock, testManagerKey, nil)
+	index.Snippets("file-1", "fp", parseFunc(&parsed))
+	index.Save()
+	assert.Contains(t, string(*data), `"version":"`+storeVersion+`"`)
+
+	*data = []byte(strings.Replace(string(*data), storeVersion, "1.0", 1))
+
+	// sources indexed by a previous version are parsed again
+	index = Load(cacheMock, testManagerKey, nil)
+	index.Snippets("file-1", "fp", parseFunc(&parsed))
+	index.Save()
+	assert.Equal(t, 2, parsed)
+	assert.Contains(t, string(*data), `"version":"`+storeVersion+`"`)
+}
+
+func Test_Index_RemovedSource(t *testing.T) {
+	cacheMock, data := newTestCache()
+	parsed := 0
+
+	index := Load(cacheMock, testManagerKey, nil)
+	index.Snippets("file-1", "fp", parseFunc(&parsed))
+	index.Snippets("file-2", "fp", parseFunc(&parsed))
+	index.Save()
+	assert.Contains(t, string(*data), "file-2")
+
+	index = Load(cacheMock, testManagerKey, nil)
+	index.Snippets("file-1", "fp", parseFunc(&parsed))
+	index.Save()
+	assert.Equal(t, 2, parsed)
+	assert.NotContains(t, string(*data), "file-2")
+	cacheMock.AssertNumberOfCalls(t, "PutData", 2)
+
+	// nothing changed so that the index is not stored again
+	index = Load(cacheMock, testManagerKey, nil)
+	index.Snippets("file-1", "fp", parseFunc(&parsed))
+	index.Save()
+	cacheMock.AssertNumberOfCalls(t, "PutData", 2)
+}
+
+func Test_Index_FormatFunc(t *testing.T) {
+	cacheMock, _ := newTestCache()
+	format := func(content string, _ []model.Parameter, values []string, _ model.SnippetFormatOptions) string {
+		return content + values[0]
+	}
+
+	parsed := 0
+	index := Load(cacheMock, testManagerKey, nil, WithFormatFunc(format))
+	index.Snippets("file", "fp", parseFunc(&parsed, testutil.TestSnippet{Content: "foo"}))
+	index.Save()
+
+	snippets := Load(cacheMock, testManagerKey, nil, WithFormatFunc(format)).Snippets("file", "fp", parseFunc(&parsed))
+	assert.Equal(t, "foobar", snippets[0].Format([]string{"bar"}, model.SnippetFormatOptions{}))
+}
+
+func Test_Index_Nil(t *testing.T) {
+	index := Load(nil, testManagerKey, nil)
+	assert.Nil(t, index)
+
+	parsed := 0
+	index.Snippets("file", "fp", parseFunc(&parsed))
+	index.Snippets("file", "fp", parseFunc(&parsed))
+	index.Save()
+	assert.Equal(t, 2, parsed)
+}
+
+func Test_FileFingerprint(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	path := filepath.Join("/dir", "file.sh")
+	assert.NoError(t, afero.WriteFile(fs, path, []byte("foo"), 0o600))
+
+	fingerprint := FileFingerprint(fs, path, "/dir/missing")
+	assert.NotEmpty(t, fingerprint)
+	assert.Equal(t, fingerprint, FileFingerprint(fs, path, "/dir/missing"))
+
+	assert.NoError(t, afero.WriteFile(fs, path, []byte("foobar"), 0o600))
+	assert.NotEqual(t, fingerprint, FileFingerprint(fs, path, "/dir/missing"))
+}
@@ -0,0 +1,41 @@
+package index
+
+import "github.com/lemoony/snipkit/internal/model"
+
+// snippet is restored from the index without parsing its source again.
+type snippet struct {
+	entry  entry
+	format FormatFunc
+}
+
+func (s snippet) GetID() string {
+	return s.entry.ID
+}
+
+func (s snippet) GetTitle() string {
+	return s.entry.Title
+}
+
+func (s snippet) GetTags() []string {
+	return s.entry.Tags
+}
+
+func (s snippet) GetContent() string {
+	return s.entry.Content
+}
+
+func (s snippet) GetLanguage() model.Language {
+	return s.entry.Language
+}
+
+func (s snippet) GetParameters() []model.Parameter {
+	return s.entry.Parameters
+}
+
+func (s snippet) Format(values []string, options model.SnippetFormatOptions) string {
+	return s.format(s.entry.Content, s.entry.Parameters, values, options)
+}
+
+func (s snippet) GetMetadata() model.SnippetMetadata {
+	return s.entry.Metadata
+}
@@ -0,0 +1,35 @@
+package cheat
+
+import (
+	"path/filepath"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+type Config struct {
+	Enabled     bool              `yaml:"enabled" head_comment:"If set to false, cheatsheets will not be provided to you."`
+	ConfigPath  string            `yaml:"configPath" head_comment:"Path to the configuration file of cheat (conf.yml). All cheatpaths defined in this file are used. Leave empty if not available."`
+	CheatPaths  []CheatPathConfig `yaml:"cheatPaths" head_comment:"Additional cheatpaths (defined the same way as in the configuration file of cheat)."`
+	IncludeTags []string          `yaml:"includeTags" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you."`
+}
+
+type CheatPathConfig struct {
+	Name     string   `yaml:"name" head_comment:"Name of the cheatpath."`
+	Path     string   `yaml:"path" head_comment:"Directory holding the cheatsheets."`
+	Tags     []string `yaml:"tags" head_comment:"Tags which are applied to all cheatsheets of this cheatpath."`
+	ReadOnly bool     `yaml:"readonly" head_comment:"Marks the cheatsheets of this cheatpath as read-only."`
+}
+
+func AutoDiscoveryConfig(system *system.System) *Config {
+	for _, candidate := range knownConfigPaths {
+		path := filepath.Join(system.UserHome(), candidate)
+		if system.FileExists(path) {
+			return &Config{Enabled: true, ConfigPath: path}
+		}
+	}
+
+	return &Config{
+		Enabled:    false,
+		ConfigPath: filepath.Join(system.UserHome(), knownConfigPaths[0]),
+	}
+}
@@ -0,0 +1,31 @@
+package cheat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		userHomeDir  string
+		enabled      bool
+		expectedPath string
+	}{
+		{name: "found", userHomeDir: testDataUserHome, enabled: true, expectedPath: testDataConfigPath},
+		{name: "not found", userHomeDir: "testdata/not-found-dir", enabled: false, expectedPath: "testdata/not-found-dir/.config/cheat/conf.yml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testutil.NewTestSystem(system.WithUserHome(tt.userHomeDir))
+			cfg := AutoDiscoveryConfig(s)
+			assert.Equal(t, tt.enabled, cfg.Enabled)
+			assert.Equal(t, tt.expectedPath, cfg.ConfigPath)
+		})
+	}
+}
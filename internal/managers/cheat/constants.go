@@ -0,0 +1,17 @@
+package cheat
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	frontMatterDelimiter = "---"
+	commentPrefix        = "#"
+	parameterHintPrefix  = "# ${"
+
+	idPrefix idutil.IDPrefix = "cheat"
+)
+
+// knownConfigPaths lists the locations (relative to the user home) where cheat looks for its configuration file.
+var knownConfigPaths = []string{
+	".config/cheat/conf.yml",
+	".cheat/conf.yml",
+}
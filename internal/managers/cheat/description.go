@@ -0,0 +1,14 @@
+package cheat
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("cheat")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "cheat - Interactive Cheatsheets",
+		Description: "Use the cheatsheets of cheat (github.com/cheat/cheat)",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
@@ -0,0 +1,180 @@
+package cheat
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+}
+
+// cheatConfigFile represents the parts of the configuration file of cheat which are relevant for SnipKit.
+type cheatConfigFile struct {
+	CheatPaths []CheatPathConfig `yaml:"cheatpaths"`
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Cheat enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	if m.config.ConfigPath != "" {
+		lines = append(lines, model.InfoLine{
+			IsError: !m.system.FileExists(m.expandHome(m.config.ConfigPath)),
+			Key:     "Cheat config path",
+			Value:   m.config.ConfigPath,
+		})
+	}
+
+	var paths []string
+	for _, cheatPath := range m.cheatPaths() {
+		paths = append(paths, cheatPath.Path)
+	}
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Cheat cheatpaths",
+		Value:   strings.Join(paths, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "Cheat total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+
+	for _, cheatPath := range m.cheatPaths() {
+		dir := m.expandHome(cheatPath.Path)
+		if !m.system.DirExists(dir) {
+			log.Warn().Str("path", dir).Msg("cheatpath does not exist")
+			continue
+		}
+
+		for _, sheetPath := range m.sheetFiles(dir) {
+			name, _ := filepath.Rel(dir, sheetPath)
+			metadata := model.SnippetMetadata{Manager: Key, Source: sheetPath, Modified: m.system.ModTime(sheetPath), ReadOnly: true}
+			for _, snippet := range parseSheet(sheetPath, filepath.ToSlash(name), string(m.system.ReadFile(sheetPath)), cheatPath.Tags) {
+				snippet.metadata = metadata
+				if tagutil.HasValidTag(validTags, snippet.GetTags()) {
+					result = append(result, snippet)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+// cheatPaths returns the cheatpaths of the configuration file of cheat followed by the ones configured explicitly.
+func (m *Manager) cheatPaths() []CheatPathConfig {
+	var result []CheatPathConfig
+
+	if configPath := m.expandHome(m.config.ConfigPath); configPath != "" && m.system.FileExists(configPath) {
+		var configFile cheatConfigFile
+		if err := yaml.Unmarshal(m.system.ReadFile(configPath), &configFile); err != nil {
+			panic(errors.Wrapf(err, "failed to parse cheat config file %s", configPath))
+		}
+		result = append(result, configFile.CheatPaths...)
+	}
+
+	return append(result, m.config.CheatPaths...)
+}
+
+// sheetFiles returns all cheatsheets within the given directory. Like cheat itself, hidden files and directories
+// are ignored.
+func (m *Manager) sheetFiles(dir string) []string {
+	var result []string
+
+	entries, err := afero.ReadDir(m.system.Fs, dir)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		entryPath := filepath.Join(dir, entry.Name())
+		if entry.IsDir() {
+			result = append(result, m.sheetFiles(entryPath)...)
+		} else {
+			result = append(result, entryPath)
+		}
+	}
+
+	return result
+}
+
+func (m *Manager) expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		return filepath.Join(m.system.UserHome(), strings.TrimPrefix(path, "~"))
+	}
+	return path
+}
@@ -0,0 +1,103 @@
+package cheat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+const (
+	testDataUserHome   = "testdata/userhome"
+	testDataConfigPath = testDataUserHome + "/.config/cheat/conf.yml"
+)
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{Enabled: true, ConfigPath: testDataConfigPath}
+
+	manager, err := NewManager(WithSystem(testutil.NewTestSystem(system.WithUserHome(testDataUserHome))), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 4)
+
+	assert.Equal(t, "Cheat enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "Cheat config path", info[1].Key)
+	assert.Equal(t, testDataConfigPath, info[1].Value)
+	assert.False(t, info[1].IsError)
+
+	assert.Equal(t, "Cheat cheatpaths", info[2].Key)
+	assert.Equal(t, "testdata/cheatsheets/community,~/personal", info[2].Value)
+
+	assert.Equal(t, "Cheat total number of snippets", info[3].Key)
+	assert.Equal(t, "5", info[3].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name                     string
+		config                   Config
+		expectedNumberOfSnippets int
+	}{
+		{name: "config file", config: Config{ConfigPath: testDataConfigPath}, expectedNumberOfSnippets: 5},
+		{name: "include tag of cheatpath", config: Config{ConfigPath: testDataConfigPath, IncludeTags: []string{"personal"}}, expectedNumberOfSnippets: 1},
+		{name: "include tag of front matter", config: Config{ConfigPath: testDataConfigPath, IncludeTags: []string{"compression"}}, expectedNumberOfSnippets: 3},
+		{name: "include sheet name", config: Config{ConfigPath: testDataConfigPath, IncludeTags: []string{"sub/kubectl"}}, expectedNumberOfSnippets: 1},
+		{
+			name:                     "explicit cheatpath",
+			config:                   Config{CheatPaths: []CheatPathConfig{{Name: "personal", Path: "~/personal"}}},
+			expectedNumberOfSnippets: 1,
+		},
+		{
+			name:                     "not existing",
+			config:                   Config{ConfigPath: "testdata/not-existing.yml", CheatPaths: []CheatPathConfig{{Path: "testdata/not-existing"}}},
+			expectedNumberOfSnippets: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Enabled = true
+			s := testutil.NewTestSystem(system.WithUserHome(testDataUserHome))
+			manager, _ := NewManager(WithSystem(s), WithConfig(tt.config))
+			assert.Len(t, manager.GetSnippets(), tt.expectedNumberOfSnippets)
+		})
+	}
+}
+
+func Test_GetSnippets_language(t *testing.T) {
+	config := Config{Enabled: true, CheatPaths: []CheatPathConfig{{Path: "testdata/cheatsheets/community/sub"}}}
+	manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, model.LanguageYAML, snippets[0].GetLanguage())
+	assert.Equal(t, "kubectl: apiVersion: v1", snippets[0].GetTitle())
+
+	metadata := snippets[0].GetMetadata()
+	assert.Equal(t, Key, metadata.Manager)
+	assert.Equal(t, "testdata/cheatsheets/community/sub/kubectl", metadata.Source)
+	assert.True(t, metadata.ReadOnly)
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
@@ -0,0 +1,144 @@
+package cheat
+
+import (
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+var languageMapping = map[string]model.Language{
+	"":      model.LanguageBash,
+	"sh":    model.LanguageBash,
+	"bash":  model.LanguageBash,
+	"shell": model.LanguageBash,
+	"zsh":   model.LanguageBash,
+	"yaml":  model.LanguageYAML,
+	"yml":   model.LanguageYAML,
+	"md":    model.LanguageMarkdown,
+	"toml":  model.LanguageTOML,
+	"text":  model.LanguageText,
+	"txt":   model.LanguageText,
+	"py":    model.LanguagePython,
+	"ruby":  model.LanguageRuby,
+	"rb":    model.LanguageRuby,
+	"node":  model.LanguageJavaScript,
+	"js":    model.LanguageJavaScript,
+	"pwsh":  model.LanguagePowerShell,
+	"ps1":   model.LanguagePowerShell,
+	"perl":  model.LanguagePerl,
+	"pl":    model.LanguagePerl,
+	"sql":   model.LanguageSQL,
+	"json":  model.LanguageJSON,
+
+	"markdown":   model.LanguageMarkdown,
+	"python":     model.LanguagePython,
+	"javascript": model.LanguageJavaScript,
+	"powershell": model.LanguagePowerShell,
+	"dockerfile": model.LanguageDockerfile,
+	"docker":     model.LanguageDockerfile,
+}
+
+type frontMatter struct {
+	Syntax string   `yaml:"syntax"`
+	Tags   []string `yaml:"tags"`
+}
+
+// block represents a single example of a cheatsheet: the leading comment lines form the title, all other lines are
+// the content of the snippet.
+type block struct {
+	comments []string
+	lines    []string
+}
+
+func parseSheet(path string, name string, contents string, cheatPathTags []string) []*snippetImpl {
+	header, body := splitFrontMatter(contents)
+
+	var matter frontMatter
+	if err := yaml.Unmarshal([]byte(header), &matter); err != nil {
+		panic(errors.Wrapf(err, "invalid front matter in cheatsheet %s", path))
+	}
+
+	tags := append([]string{name}, matter.Tags...)
+	tags = append(tags, cheatPathTags...)
+	language := mapLanguage(matter.Syntax)
+
+	var result []*snippetImpl
+	for _, b := range splitBlocks(body) {
+		title := strings.Join(b.comments, " ")
+		if title == "" {
+			title = fmt.Sprintf("%s: %s", name, strings.TrimSpace(b.lines[0]))
+		}
+
+		result = append(result, &snippetImpl{
+			id:       idutil.FormatSnippetID(fmt.Sprintf("%s#%d", path, len(result)), idPrefix),
+			title:    title,
+			content:  strings.Join(b.lines, "\n"),
+			tags:     tags,
+			language: language,
+		})
+	}
+
+	return result
+}
+
+// splitFrontMatter returns the YAML front matter (if available) and the remaining contents of a cheatsheet.
+func splitFrontMatter(contents string) (string, string) {
+	lines := strings.Split(contents, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return "", contents
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == frontMatterDelimiter {
+			return strings.Join(lines[1:i], "\n"), strings.Join(lines[i+1:], "\n")
+		}
+	}
+
+	return "", contents
+}
+
+// splitBlocks splits the body of a cheatsheet into examples. An example ends with an empty line or if a comment
+// follows the content of the example. Blocks consisting of comments only are dropped.
+func splitBlocks(body string) []block {
+	var result []block
+	var current block
+
+	flush := func() {
+		if len(current.lines) > 0 {
+			result = append(result, current)
+		}
+		current = block{}
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			flush()
+		case strings.HasPrefix(trimmed, commentPrefix) && !strings.HasPrefix(trimmed, parameterHintPrefix):
+			if len(current.lines) > 0 {
+				flush()
+			}
+			current.comments = append(current.comments, strings.TrimSuffix(strings.TrimSpace(strings.TrimPrefix(trimmed, commentPrefix)), ":"))
+		default:
+			current.lines = append(current.lines, line)
+		}
+	}
+	flush()
+
+	return result
+}
+
+func mapLanguage(syntax string) model.Language {
+	if l, ok := languageMapping[strings.ToLower(strings.TrimSpace(syntax))]; ok {
+		return l
+	}
+	return model.LanguageText
+}
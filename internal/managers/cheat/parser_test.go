@@ -0,0 +1,67 @@
+package cheat
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+func Test_parseSheet(t *testing.T) {
+	contents, err := os.ReadFile("testdata/cheatsheets/community/tar")
+	assert.NoError(t, err)
+
+	snippets := parseSheet("tar", "tar", string(contents), []string{"community"})
+	assert.Len(t, snippets, 3)
+
+	assert.Equal(t, "To extract an uncompressed archive", snippets[0].GetTitle())
+	assert.Equal(t, "tar -xvf /path/to/foo.tar", snippets[0].GetContent())
+	assert.Equal(t, []string{"tar", "compression", "community"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+
+	assert.Equal(t, "To create an uncompressed archive", snippets[1].GetTitle())
+	assert.Equal(t, "tar -cvf /path/to/foo.tar /path/to/foo/", snippets[1].GetContent())
+
+	assert.Equal(t, "To extract a .gz archive", snippets[2].GetTitle())
+	assert.NotEqual(t, snippets[0].GetID(), snippets[1].GetID())
+}
+
+func Test_parseSheet_withoutFrontMatter(t *testing.T) {
+	snippets := parseSheet("foo", "foo", "# Say hello\n# ${NAME} Name: Name\necho hello ${NAME}\n\necho bye", nil)
+	assert.Len(t, snippets, 2)
+
+	assert.Equal(t, "Say hello", snippets[0].GetTitle())
+	assert.Equal(t, "# ${NAME} Name: Name\necho hello ${NAME}", snippets[0].GetContent())
+	assert.Len(t, snippets[0].GetParameters(), 1)
+	assert.Equal(t, []string{"foo"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+
+	assert.Equal(t, "foo: echo bye", snippets[1].GetTitle())
+}
+
+func Test_parseSheet_invalidFrontMatter(t *testing.T) {
+	assert.Panics(t, func() {
+		parseSheet("foo", "foo", "---\ntags: [\n---\necho foo", nil)
+	})
+}
+
+func Test_mapLanguage(t *testing.T) {
+	tests := []struct {
+		syntax   string
+		expected model.Language
+	}{
+		{syntax: "", expected: model.LanguageBash},
+		{syntax: "sh", expected: model.LanguageBash},
+		{syntax: "YAML", expected: model.LanguageYAML},
+		{syntax: "markdown", expected: model.LanguageMarkdown},
+		{syntax: "toml", expected: model.LanguageTOML},
+		{syntax: "foo", expected: model.LanguageText},
+	}
+	for _, tt := range tests {
+		t.Run(tt.syntax, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapLanguage(tt.syntax))
+		})
+	}
+}
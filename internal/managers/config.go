@@ -1,19 +1,37 @@
 package managers
 
 import (
+	"github.com/lemoony/snipkit/internal/managers/cheat"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
 	"github.com/lemoony/snipkit/internal/managers/githubgist"
+	"github.com/lemoony/snipkit/internal/managers/gitlab"
+	"github.com/lemoony/snipkit/internal/managers/gitrepo"
 	"github.com/lemoony/snipkit/internal/managers/masscode"
+	"github.com/lemoony/snipkit/internal/managers/navi"
+	"github.com/lemoony/snipkit/internal/managers/notebook"
 	"github.com/lemoony/snipkit/internal/managers/pet"
 	"github.com/lemoony/snipkit/internal/managers/pictarinesnip"
+	"github.com/lemoony/snipkit/internal/managers/shellhistory"
 	"github.com/lemoony/snipkit/internal/managers/snippetslab"
+	"github.com/lemoony/snipkit/internal/managers/tasks"
+	"github.com/lemoony/snipkit/internal/managers/tldr"
+	"github.com/lemoony/snipkit/internal/managers/vscode"
 )
 
 type Config struct {
-	SnippetsLab   *snippetslab.Config   `yaml:"snippetsLab,omitempty" mapstructure:"snippetsLab"`
-	PictarineSnip *pictarinesnip.Config `yaml:"pictarineSnip,omitempty" mapstructure:"pictarineSnip"`
-	Pet           *pet.Config           `yaml:"pet,omitempty" mapstructure:"pet"`
-	MassCode      *masscode.Config      `yaml:"massCode,omitempty" mapstructure:"massCode"`
-	GithubGist    *githubgist.Config    `yaml:"githubGist,omitempty" mapstructure:"githubGist"`
-	FsLibrary     *fslibrary.Config     `yaml:"fsLibrary,omitempty" mapstructure:"fsLibrary"`
+	SnippetsLab      *snippetslab.Config   `yaml:"snippetsLab,omitempty" mapstructure:"snippetsLab"`
+	PictarineSnip    *pictarinesnip.Config `yaml:"pictarineSnip,omitempty" mapstructure:"pictarineSnip"`
+	Pet              *pet.Config           `yaml:"pet,omitempty" mapstructure:"pet"`
+	MassCode         *masscode.Config      `yaml:"massCode,omitempty" mapstructure:"massCode"`
+	GithubGist       *githubgist.Config    `yaml:"githubGist,omitempty" mapstructure:"githubGist"`
+	FsLibrary        *fslibrary.Config     `yaml:"fsLibrary,omitempty" mapstructure:"fsLibrary"`
+	GitRepository    *gitrepo.Config       `yaml:"gitRepository,omitempty" mapstructure:"gitRepository"`
+	GitLab           *gitlab.Config        `yaml:"gitLab,omitempty" mapstructure:"gitLab"`
+	Navi             *navi.Config          `yaml:"navi,omitempty" mapstructure:"navi"`
+	Tldr             *tldr.Config          `yaml:"tldr,omitempty" mapstructure:"tldr"`
+	ShellHistory     *shellhistory.Config  `yaml:"shellHistory,omitempty" mapstructure:"shellHistory"`
+	Cheat            *cheat.Config         `yaml:"cheat,omitempty" mapstructure:"cheat"`
+	VSCode           *vscode.Config        `yaml:"vscode,omitempty" mapstructure:"vscode"`
+	MarkdownNotebook *notebook.Config      `yaml:"markdownNotebook,omitempty" mapstructure:"markdownNotebook"`
+	ProjectTasks     *tasks.Config         `yaml:"projectTasks,omitempty" mapstructure:"projectTasks"`
 }
@@ -6,11 +6,13 @@ import (
 
 type Config struct {
 	Enabled                   bool     `yaml:"enabled" head_comment:"If set to false, the files specified via libraryPath will not be provided to you."`
-	LibraryPath               []string `yaml:"libraryPath" head_comment:"Paths directories that hold snippets files (use absolute paths!). Each file must hold one snippet only."`
+	LibraryPath               []string `yaml:"libraryPath" head_comment:"Paths directories that hold snippets files (use absolute paths!)."`
 	AssistantLibraryPathIndex int      `yaml:"assistantLibraryPathIndex" head_comment:"Index of library path where to store snippets created by the assistant."`
 	SuffixRegex               []string `yaml:"suffixRegex" head_comment:"Only files with endings which match one of the listed suffixes will be considered."`
 	LazyOpen                  bool     `yaml:"lazyOpen" head_comment:"If set to true, the files will not be parsed in advance. This means, only the filename can be used as the snippet name."`
 	HideTitleInPreview        bool     `yaml:"hideTitleInPreview" head_comment:"If set to true, the title comment will not be shown in the preview window."`
+	SnippetSeparator          string   `yaml:"snippetSeparator,omitempty" head_comment:"Lines equal to the separator split a file into multiple snippets. Requires lazyOpen to be false."`
+	IncludeTags               []string `yaml:"includeTags,omitempty" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you."`
 }
 
 func AutoDiscoveryConfig(system *system.System) *Config {
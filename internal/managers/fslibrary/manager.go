@@ -7,14 +7,19 @@ import (
 	"regexp"
 	"strings"
 
+	"emperror.dev/errors"
 	"github.com/phuslu/log"
 	"github.com/spf13/afero"
 
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/index"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/ui"
 	"github.com/lemoony/snipkit/internal/ui/uimsg"
 	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
 	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
 )
 
 var suffixLanguageMap = map[string]model.Language{
@@ -23,10 +28,52 @@ var suffixLanguageMap = map[string]model.Language{
 	".yml":  model.LanguageYAML,
 	".md":   model.LanguageMarkdown,
 	".toml": model.LanguageTOML,
+	".py":   model.LanguagePython,
+	".rb":   model.LanguageRuby,
+	".js":   model.LanguageJavaScript,
+	".mjs":  model.LanguageJavaScript,
+	".ps1":  model.LanguagePowerShell,
+	".pl":   model.LanguagePerl,
+	".sql":  model.LanguageSQL,
+	".json": model.LanguageJSON,
+
+	".dockerfile": model.LanguageDockerfile,
+}
+
+var languageNameMap = map[string]model.Language{
+	"bash":     model.LanguageBash,
+	"sh":       model.LanguageBash,
+	"shell":    model.LanguageBash,
+	"zsh":      model.LanguageBash,
+	"yaml":     model.LanguageYAML,
+	"yml":      model.LanguageYAML,
+	"markdown": model.LanguageMarkdown,
+	"md":       model.LanguageMarkdown,
+	"toml":     model.LanguageTOML,
+	"text":     model.LanguageText,
+	"txt":      model.LanguageText,
+	"python":   model.LanguagePython,
+	"py":       model.LanguagePython,
+	"ruby":     model.LanguageRuby,
+	"rb":       model.LanguageRuby,
+	"node":     model.LanguageJavaScript,
+	"js":       model.LanguageJavaScript,
+	"pwsh":     model.LanguagePowerShell,
+	"ps1":      model.LanguagePowerShell,
+	"perl":     model.LanguagePerl,
+	"pl":       model.LanguagePerl,
+	"sql":      model.LanguageSQL,
+	"docker":   model.LanguageDockerfile,
+	"json":     model.LanguageJSON,
+
+	"javascript": model.LanguageJavaScript,
+	"powershell": model.LanguagePowerShell,
+	"dockerfile": model.LanguageDockerfile,
 }
 
 type Manager struct {
 	system      *system.System
+	cache       cache.Cache
 	config      Config
 	suffixRegex []*regexp.Regexp
 	printer     ui.MessagePrinter
@@ -57,6 +104,13 @@ func WithConfig(config Config) Option {
 	})
 }
 
+// WithCache sets the cache which holds the index of parsed snippet files.
+func WithCache(cache cache.Cache) Option {
+	return optionFunc(func(p *Manager) {
+		p.cache = cache
+	})
+}
+
 func WithPrinter(printer ui.MessagePrinter) Option {
 	return optionFunc(func(p *Manager) {
 		p.printer = printer
@@ -68,6 +122,9 @@ func NewManager(options ...Option) (*Manager, error) {
 	for _, o := range options {
 		o.apply(manager)
 	}
+	if manager.config.SnippetSeparator == frontMatterDelimiter {
+		return nil, errors.Errorf("the snippet separator must not equal the front matter delimiter %s", frontMatterDelimiter)
+	}
 	manager.compileSuffixRegex()
 	return manager, nil
 }
@@ -86,7 +143,7 @@ func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, cont
 			Msg("Saving assistant snippet to filesystem")
 
 		m.system.CreatePath(file)
-		m.system.WriteFile(file, []byte(formatSnippet(string(contents), snippetTitle)))
+		m.system.WriteFile(file, []byte(m.formatNewSnippet(snippetTitle, string(contents), nil)))
 		m.printer.Print(uimsg.AssistantSnippetSaved(snippetTitle, file))
 	} else {
 		log.Error().Err(err).Str("filename", filename).Msg("Failed to resolve absolute path for snippet")
@@ -119,10 +176,14 @@ func (m Manager) Info() []model.InfoLine {
 }
 
 func (m *Manager) GetSnippets() []model.Snippet {
+	snippetIndex := index.Load(m.cache, Key, m.config)
+
 	var result []model.Snippet
 	for _, dir := range m.config.LibraryPath {
-		result = append(result, m.snippetsFromDir(dir)...)
+		result = append(result, m.snippetsFromDir(dir, snippetIndex)...)
 	}
+
+	snippetIndex.Save()
 	return result
 }
 
@@ -130,7 +191,9 @@ func (m *Manager) Sync(model.SyncEventChannel) {
 	// do nothing
 }
 
-func (m *Manager) snippetsFromDir(dir string) []model.Snippet {
+// snippetsFromDir returns the snippets of all files within the directory. Files which have not been modified since
+// they were indexed are not parsed again.
+func (m *Manager) snippetsFromDir(dir string, snippetIndex *index.Index) []model.Snippet {
 	var result []model.Snippet
 
 	entries, err := afero.ReadDir(m.system.Fs, dir)
@@ -140,7 +203,7 @@ func (m *Manager) snippetsFromDir(dir string) []model.Snippet {
 
 	for _, entry := range entries {
 		if entry.IsDir() {
-			result = append(result, m.snippetsFromDir(path.Join(dir, entry.Name()))...)
+			result = append(result, m.snippetsFromDir(path.Join(dir, entry.Name()), snippetIndex)...)
 			continue
 		}
 
@@ -151,32 +214,107 @@ func (m *Manager) snippetsFromDir(dir string) []model.Snippet {
 			continue
 		}
 
-		snippet := snippetImpl{
-			id:   idutil.FormatSnippetID(filePath, idPrefix),
-			path: filePath,
-			tags: []string{},
-			contentFunc: func() string {
-				contents := string(m.system.ReadFile(filePath))
-				if m.config.HideTitleInPreview {
-					contents = pruneTitleHeader(strings.NewReader(contents))
-				}
-				return contents
-			},
-			titleFunc: func() string {
-				if m.config.LazyOpen {
-					return fileName
-				} else {
-					return m.getSnippetName(filePath)
-				}
-			},
+		if m.config.LazyOpen {
+			result = append(result, m.lazySnippet(filePath, fileName))
+		} else {
+			result = append(result, snippetIndex.Snippets(filePath, index.FileFingerprint(m.system.Fs, filePath), func() []model.Snippet {
+				return m.snippetsFromFile(filePath, fileName)
+			})...)
 		}
+	}
+
+	return result
+}
 
-		result = append(result, &snippet)
+// lazySnippet returns a snippet for the file which is only read when the content of the snippet is requested.
+func (m *Manager) lazySnippet(filePath, fileName string) model.Snippet {
+	return &snippetImpl{
+		id:       idutil.FormatSnippetID(filePath, idPrefix),
+		path:     filePath,
+		tags:     []string{},
+		language: LanguageForSuffix(filepath.Ext(fileName)),
+		contentFunc: func() string {
+			contents := string(m.system.ReadFile(filePath))
+			if m.config.HideTitleInPreview {
+				contents = pruneTitleHeader(strings.NewReader(contents))
+			}
+			return contents
+		},
+		titleFunc: func() string {
+			return fileName
+		},
+		metadata: model.SnippetMetadata{Manager: Key, Source: filePath, Modified: m.system.ModTime(filePath)},
 	}
+}
+
+// snippetsFromFile parses the file which may hold multiple snippets. If the file holds a single snippet only, the ID
+// of the snippet is derived from the path. Otherwise, the anchor of the snippet is appended to the path.
+func (m *Manager) snippetsFromFile(filePath, fileName string) []model.Snippet {
+	_, fileSnippets := parseSnippetFile(string(m.system.ReadFile(filePath)), m.config.SnippetSeparator)
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+	modTime := m.system.ModTime(filePath)
 
+	var result []model.Snippet
+	for i, s := range fileSnippets {
+		if !tagutil.HasValidTag(validTags, s.matter.Tags) {
+			continue
+		}
+
+		title := s.title()
+		if title == "" {
+			title = defaultTitle(fileName, i, len(fileSnippets))
+		}
+
+		content := s.body
+		if m.config.HideTitleInPreview {
+			content = pruneTitleHeader(strings.NewReader(content))
+		}
+
+		tags := s.matter.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		language := LanguageForSuffix(filepath.Ext(fileName))
+		if l, ok := languageForName(s.matter.Language); ok {
+			language = l
+		}
+
+		result = append(result, &snippetImpl{
+			id:             idutil.FormatSnippetID(snippetPathWithAnchor(filePath, s.anchor), idPrefix),
+			path:           filePath,
+			anchor:         s.anchor,
+			hasFrontMatter: s.hasFrontMatter,
+			tags:           tags,
+			language:       language,
+			contentFunc:    func() string { return content },
+			titleFunc:      func() string { return title },
+			metadata: model.SnippetMetadata{
+				Description: s.matter.Description,
+				Manager:     Key,
+				Source:      filePath,
+				Modified:    modTime,
+			},
+		})
+	}
 	return result
 }
 
+// defaultTitle is used for snippets without a title defined via front matter or title header.
+func defaultTitle(fileName string, index, count int) string {
+	if count > 1 {
+		return fmt.Sprintf("%s #%d", fileName, index+1)
+	}
+	return fileName
+}
+
+func snippetPathWithAnchor(filePath, anchor string) string {
+	if anchor == "" {
+		return filePath
+	}
+	return fmt.Sprintf("%s#%s", filePath, anchor)
+}
+
 func checkSuffix(filename string, regexes []*regexp.Regexp) bool {
 	if len(regexes) == 0 {
 		return true
@@ -200,8 +338,9 @@ func (m *Manager) compileSuffixRegex() {
 	}
 }
 
-func (m *Manager) getSnippetName(filePath string) string {
-	return getSnippetName(m.system, filePath)
+func languageForName(name string) (model.Language, bool) {
+	l, ok := languageNameMap[strings.ToLower(strings.TrimSpace(name))]
+	return l, ok
 }
 
 func LanguageForSuffix(suffix string) model.Language {
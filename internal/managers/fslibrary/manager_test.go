@@ -9,10 +9,13 @@ import (
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/lemoony/snipkit/internal/cache"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/idutil"
 	"github.com/lemoony/snipkit/internal/utils/testutil"
+	cacheMocks "github.com/lemoony/snipkit/mocks/cache"
 )
 
 func Test_GetInfo(t *testing.T) {
@@ -167,6 +170,9 @@ func Test_languageForSuffix(t *testing.T) {
 		{suffix: ".yml", expected: model.LanguageYAML},
 		{suffix: ".md", expected: model.LanguageMarkdown},
 		{suffix: ".toml", expected: model.LanguageTOML},
+		{suffix: ".py", expected: model.LanguagePython},
+		{suffix: ".js", expected: model.LanguageJavaScript},
+		{suffix: ".dockerfile", expected: model.LanguageDockerfile},
 		{suffix: ".txt", expected: model.LanguageUnknown},
 	}
 
@@ -176,3 +182,128 @@ func Test_languageForSuffix(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetSnippets_MultipleSnippetsPerFile(t *testing.T) {
+	config := Config{
+		Enabled:          true,
+		LibraryPath:      []string{t.TempDir()},
+		SuffixRegex:      []string{".sh", ".yaml"},
+		SnippetSeparator: "# ---",
+		IncludeTags:      []string{"snipkit"},
+	}
+
+	system := testutil.NewTestSystem()
+	filePath := filepath.Join(config.LibraryPath[0], "topic.sh")
+	system.WriteFile(filePath, []byte(`---
+title: First
+tags: [snipkit, foo]
+---
+echo first
+# ---
+---
+title: Second
+tags: [snipkit]
+language: yaml
+---
+foo: bar
+# ---
+---
+title: Excluded
+tags: [other]
+---
+echo excluded
+`))
+
+	provider, err := NewManager(WithSystem(system), WithConfig(config))
+	assert.NoError(t, err)
+
+	snippets := provider.GetSnippets()
+	assert.Len(t, snippets, 2)
+
+	assert.Equal(t, idutil.FormatSnippetID(filePath+"#first", idPrefix), snippets[0].GetID())
+	assert.Equal(t, "First", snippets[0].GetTitle())
+	assert.Equal(t, []string{"snipkit", "foo"}, snippets[0].GetTags())
+	assert.Equal(t, "echo first", snippets[0].GetContent())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+
+	assert.Equal(t, idutil.FormatSnippetID(filePath+"#second", idPrefix), snippets[1].GetID())
+	assert.Equal(t, "Second", snippets[1].GetTitle())
+	assert.Equal(t, "foo: bar", snippets[1].GetContent())
+	assert.Equal(t, model.LanguageYAML, snippets[1].GetLanguage())
+}
+
+func Test_GetSnippets_Metadata(t *testing.T) {
+	config := Config{Enabled: true, LibraryPath: []string{t.TempDir()}}
+
+	system := testutil.NewTestSystem()
+	filePath := filepath.Join(config.LibraryPath[0], "foo.sh")
+	system.WriteFile(filePath, []byte("---\ntitle: Foo\ndescription: Prints foo\n---\necho foo"))
+
+	provider, err := NewManager(WithSystem(system), WithConfig(config))
+	assert.NoError(t, err)
+
+	snippets := provider.GetSnippets()
+	assert.Len(t, snippets, 1)
+
+	metadata := snippets[0].GetMetadata()
+	assert.Equal(t, "Prints foo", metadata.Description)
+	assert.Equal(t, Key, metadata.Manager)
+	assert.Equal(t, filePath, metadata.Source)
+	assert.False(t, metadata.Modified.IsZero())
+	assert.False(t, metadata.ReadOnly)
+}
+
+func Test_GetSnippets_DefaultTitleWithinFile(t *testing.T) {
+	config := Config{Enabled: true, LibraryPath: []string{t.TempDir()}, SnippetSeparator: "# ---"}
+
+	system := testutil.NewTestSystem()
+	system.WriteFile(filepath.Join(config.LibraryPath[0], "topic.sh"), []byte("echo first\n# ---\necho second"))
+
+	provider, err := NewManager(WithSystem(system), WithConfig(config))
+	assert.NoError(t, err)
+
+	snippets := provider.GetSnippets()
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, "topic.sh #1", snippets[0].GetTitle())
+	assert.Equal(t, "topic.sh #2", snippets[1].GetTitle())
+}
+
+func Test_NewManager_InvalidSnippetSeparator(t *testing.T) {
+	_, err := NewManager(WithConfig(Config{SnippetSeparator: frontMatterDelimiter}))
+	assert.Error(t, err)
+}
+
+func Test_GetSnippets_Index(t *testing.T) {
+	var data []byte
+	cacheMock := cacheMocks.Cache{}
+	cacheMock.On("GetData", mock.Anything).Return(func(cache.DataKey) []byte { return data }, func(cache.DataKey) bool {
+		return data != nil
+	})
+	cacheMock.On("PutData", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		data = args.Get(1).([]byte)
+	}).Return()
+
+	config := Config{Enabled: true, LibraryPath: []string{t.TempDir()}}
+	system := testutil.NewTestSystem()
+	filePath := filepath.Join(config.LibraryPath[0], "snippet.sh")
+	system.WriteFile(filePath, []byte("#\n# Title\n#\necho foo"))
+
+	provider, err := NewManager(WithSystem(system), WithConfig(config), WithCache(&cacheMock))
+	assert.NoError(t, err)
+
+	snippets := provider.GetSnippets()
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "Title", snippets[0].GetTitle())
+	cacheMock.AssertNumberOfCalls(t, "PutData", 1)
+
+	snippets = provider.GetSnippets()
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "Title", snippets[0].GetTitle())
+	assert.Equal(t, idutil.FormatSnippetID(filePath, idPrefix), snippets[0].GetID())
+	cacheMock.AssertNumberOfCalls(t, "PutData", 1)
+
+	system.WriteFile(filePath, []byte("#\n# Modified title\n#\necho foo"))
+	snippets = provider.GetSnippets()
+	assert.Equal(t, "Modified title", snippets[0].GetTitle())
+	cacheMock.AssertNumberOfCalls(t, "PutData", 2)
+}
@@ -1,18 +1,20 @@
 package fslibrary
 
 import (
-	"path/filepath"
-
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/parser"
 )
 
 type snippetImpl struct {
-	id          string
-	path        string
-	tags        []string
-	titleFunc   func() string
-	contentFunc func() string
+	id             string
+	path           string
+	anchor         string
+	hasFrontMatter bool
+	tags           []string
+	language       model.Language
+	titleFunc      func() string
+	contentFunc    func() string
+	metadata       model.SnippetMetadata
 }
 
 func (s snippetImpl) GetID() string {
@@ -32,7 +34,7 @@ func (s snippetImpl) GetContent() string {
 }
 
 func (s snippetImpl) GetLanguage() model.Language {
-	return LanguageForSuffix(filepath.Ext(s.path))
+	return s.language
 }
 
 func (s snippetImpl) GetParameters() []model.Parameter {
@@ -42,3 +44,13 @@ func (s snippetImpl) GetParameters() []model.Parameter {
 func (s snippetImpl) Format(values []string, options model.SnippetFormatOptions) string {
 	return parser.CreateSnippet(s.GetContent(), s.GetParameters(), values, options)
 }
+
+// isSection returns true if the snippet is a part of a file which holds multiple snippets or if the snippet defines
+// front matter. In both cases, the file must not be overwritten with the content of the snippet only.
+func (s snippetImpl) isSection() bool {
+	return s.anchor != "" || s.hasFrontMatter
+}
+
+func (s snippetImpl) GetMetadata() model.SnippetMetadata {
+	return s.metadata
+}
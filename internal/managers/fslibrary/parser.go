@@ -1,19 +1,162 @@
 package fslibrary
 
 import (
+	"bytes"
+	"fmt"
 	"io"
-	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v3"
 
-	"github.com/lemoony/snipkit/internal/utils/system"
 	"github.com/lemoony/snipkit/internal/utils/titleheader"
 )
 
-func getSnippetName(system *system.System, filePath string) string {
-	contents := string(system.ReadFile(filePath))
-	if title, ok := titleheader.ParseTitleFromHeader(contents); ok {
+const frontMatterDelimiter = "---"
+
+// frontMatter holds the metadata of a snippet which can be defined at the start of a snippet.
+type frontMatter struct {
+	Title       string   `yaml:"title,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Tags        []string `yaml:"tags,omitempty"`
+	Language    string   `yaml:"language,omitempty"`
+}
+
+// fileSnippet is a single snippet within a library file.
+type fileSnippet struct {
+	// index of the raw section of the file which holds the snippet
+	index int
+	// anchor identifies the snippet within the file. It is empty if the file holds one snippet only.
+	anchor         string
+	matter         frontMatter
+	hasFrontMatter bool
+	body           string
+}
+
+// title returns the title defined via front matter or title header. If none is defined, an empty string is returned.
+func (s fileSnippet) title() string {
+	if s.matter.Title != "" {
+		return s.matter.Title
+	}
+	if title, ok := titleheader.ParseTitleFromHeader(s.body); ok {
 		return title
 	}
-	return filepath.Base(filePath)
+	return ""
+}
+
+// parseSnippetFile splits the contents of a library file into its raw sections and the snippets they hold. Sections
+// consisting of whitespace only do not hold a snippet.
+func parseSnippetFile(contents string, separator string) ([]string, []fileSnippet) {
+	sections := splitSections(contents, separator)
+
+	var snippets []fileSnippet
+	for i, raw := range sections {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		matter, body, ok := splitFrontMatter(raw)
+		snippets = append(snippets, fileSnippet{index: i, matter: matter, hasFrontMatter: ok, body: body})
+	}
+
+	if len(snippets) > 1 {
+		anchors := map[string]bool{}
+		for i := range snippets {
+			snippets[i].body = strings.Trim(snippets[i].body, "\r\n")
+			snippets[i].anchor = uniqueAnchor(anchors, slugify(snippets[i].title()), i)
+		}
+	}
+
+	return sections, snippets
+}
+
+// splitSections splits the contents at all lines equal to the separator. The sections can be joined via joinSections
+// again.
+func splitSections(contents string, separator string) []string {
+	if separator == "" {
+		return []string{contents}
+	}
+
+	var result []string
+	var current []string
+	for _, line := range strings.Split(contents, "\n") {
+		if strings.TrimSpace(line) == separator {
+			result = append(result, strings.Join(current, "\n"))
+			current = nil
+		} else {
+			current = append(current, line)
+		}
+	}
+	return append(result, strings.Join(current, "\n"))
+}
+
+func joinSections(sections []string, separator string) string {
+	return strings.Join(sections, "\n"+separator+"\n")
+}
+
+// splitFrontMatter returns the front matter and the remaining content of a section. Leading lines delimited by
+// '---' are only considered front matter if they hold known keys only, e.g., YAML documents are not mistaken for front
+// matter.
+func splitFrontMatter(section string) (frontMatter, string, bool) {
+	lines := strings.Split(strings.TrimLeft(section, "\r\n"), "\n")
+	if strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return frontMatter{}, section, false
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != frontMatterDelimiter {
+			continue
+		}
+
+		var matter frontMatter
+		decoder := yaml.NewDecoder(strings.NewReader(strings.Join(lines[1:i], "\n")))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&matter); err != nil && !errors.Is(err, io.EOF) {
+			return frontMatter{}, section, false
+		}
+		return matter, strings.TrimLeft(strings.Join(lines[i+1:], "\n"), "\r\n"), true
+	}
+
+	return frontMatter{}, section, false
+}
+
+func (f frontMatter) isEmpty() bool {
+	return f.Title == "" && f.Description == "" && len(f.Tags) == 0 && f.Language == ""
+}
+
+// formatSection prepends the front matter to the content unless it is empty and the section did not have front matter
+// before.
+func formatSection(matter frontMatter, hasFrontMatter bool, content string) string {
+	if !hasFrontMatter && matter.isEmpty() {
+		return content
+	}
+	return formatFrontMatter(matter, content)
+}
+
+// formatFrontMatter prepends the front matter to the content.
+func formatFrontMatter(matter frontMatter, content string) string {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(matter); err != nil {
+		panic(errors.Wrap(err, "failed to encode front matter"))
+	}
+	encoded := buffer.String()
+	if encoded == "{}\n" {
+		encoded = ""
+	}
+	return fmt.Sprintf("%s\n%s%s\n%s", frontMatterDelimiter, encoded, frontMatterDelimiter, content)
+}
+
+func uniqueAnchor(existing map[string]bool, anchor string, index int) string {
+	if anchor == "" {
+		anchor = fmt.Sprintf("snippet-%d", index+1)
+	}
+	result := anchor
+	for i := 2; existing[result]; i++ {
+		result = fmt.Sprintf("%s-%d", anchor, i)
+	}
+	existing[result] = true
+	return result
 }
 
 func pruneTitleHeader(r io.Reader) string {
@@ -1,14 +1,10 @@
 package fslibrary
 
 import (
-	"path/filepath"
 	"strings"
 	"testing"
 
-	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
-
-	"github.com/lemoony/snipkit/internal/utils/testutil"
 )
 
 func Test_pruneTitleComment(t *testing.T) {
@@ -28,33 +24,79 @@ func Test_pruneTitleComment(t *testing.T) {
 	}
 }
 
-func Test_getSnippetName(t *testing.T) {
+func Test_parseSnippetFile_singleSnippet(t *testing.T) {
 	tests := []struct {
-		title   string
+		name    string
 		content string
-		ok      bool
+		title   string
+		matter  bool
 	}{
-		{title: "title 1", content: "#\n# title 1\n#", ok: true},
-		{title: "title 2", content: "#title 2\n#", ok: false},
+		{name: "title header", content: "#\n# title 1\n#", title: "title 1"},
+		{name: "invalid title header", content: "#title 2\n#", title: ""},
+		{name: "front matter", content: "---\ntitle: title 3\n---\necho foo", title: "title 3", matter: true},
+		{name: "front matter precedes title header", content: "---\ntitle: title 4\n---\n#\n# other\n#", title: "title 4", matter: true},
+		{name: "yaml document", content: "---\nfoo: bar\n---\nbar: foo", title: ""},
 	}
 
-	system := testutil.NewTestSystem()
-
 	for _, tt := range tests {
-		t.Run(tt.title, func(t *testing.T) {
-			file, err := afero.TempFile(system.Fs, t.TempDir(), "*.sh")
-
-			assert.NoError(t, err)
-			if _, err = file.Write([]byte(tt.content)); err != nil {
-				assert.NoError(t, err)
-			}
-
-			name := getSnippetName(system, file.Name())
-			if tt.ok {
-				assert.Equal(t, tt.title, name)
-			} else {
-				assert.Equal(t, filepath.Base(file.Name()), name)
-			}
+		t.Run(tt.name, func(t *testing.T) {
+			sections, snippets := parseSnippetFile(tt.content, "# ---")
+			assert.Equal(t, []string{tt.content}, sections)
+			assert.Len(t, snippets, 1)
+			assert.Empty(t, snippets[0].anchor)
+			assert.Equal(t, tt.title, snippets[0].title())
+			assert.Equal(t, tt.matter, snippets[0].hasFrontMatter)
 		})
 	}
 }
+
+func Test_parseSnippetFile_multipleSnippets(t *testing.T) {
+	content := `---
+title: Say hello
+description: Prints hello
+tags: [greeting]
+language: bash
+---
+echo hello
+
+# ---
+
+#
+# Say hello
+#
+echo hello again
+
+# ---
+
+echo untitled
+# ---
+`
+
+	sections, snippets := parseSnippetFile(content, "# ---")
+	assert.Len(t, sections, 4)
+	assert.Equal(t, content, joinSections(sections, "# ---"))
+
+	assert.Len(t, snippets, 3)
+
+	assert.Equal(t, "say-hello", snippets[0].anchor)
+	assert.Equal(t, frontMatter{Title: "Say hello", Description: "Prints hello", Tags: []string{"greeting"}, Language: "bash"}, snippets[0].matter)
+	assert.Equal(t, "echo hello", snippets[0].body)
+
+	assert.Equal(t, "say-hello-2", snippets[1].anchor)
+	assert.Equal(t, "Say hello", snippets[1].title())
+	assert.Equal(t, "#\n# Say hello\n#\necho hello again", snippets[1].body)
+
+	assert.Equal(t, "snippet-3", snippets[2].anchor)
+	assert.Equal(t, 2, snippets[2].index)
+	assert.Equal(t, "echo untitled", snippets[2].body)
+}
+
+func Test_formatSection(t *testing.T) {
+	assert.Equal(t, "echo foo", formatSection(frontMatter{}, false, "echo foo"))
+	assert.Equal(t, "---\n---\necho foo", formatSection(frontMatter{}, true, "echo foo"))
+	assert.Equal(
+		t,
+		"---\ntitle: Foo\ntags:\n  - a\n---\necho foo",
+		formatSection(frontMatter{Title: "Foo", Tags: []string{"a"}}, false, "echo foo"),
+	)
+}
@@ -0,0 +1,224 @@
+package fslibrary
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+	"github.com/lemoony/snipkit/internal/utils/titleheader"
+)
+
+const defaultSnippetSuffix = ".sh"
+
+var fileNameInvalidCharsRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// CreateSnippet stores the snippet as a new file in the library path which is also used for snippets created by the
+// assistant. The title is stored as title header within the file. If the snippet has tags, title and tags are stored as
+// front matter instead.
+func (m Manager) CreateSnippet(draft model.SnippetDraft) string {
+	dirPath := m.config.LibraryPath[m.config.AssistantLibraryPathIndex]
+	filePath := m.availableFilePath(dirPath, fileNameForTitle(draft.Title, draft.Language))
+
+	log.Debug().Str("title", draft.Title).Str("path", filePath).Msg("Creating snippet file")
+
+	m.system.CreatePath(filePath)
+	m.system.WriteFile(filePath, []byte(m.formatNewSnippet(draft.Title, draft.Content, draft.Tags)))
+	return idutil.FormatSnippetID(filePath, idPrefix)
+}
+
+// UpdateSnippet overwrites the file of the snippet. If the new content does not define a title header, the title is
+// added as title header unless it equals the file name. Snippets with front matter or within a file holding multiple
+// snippets are stored with front matter.
+func (m Manager) UpdateSnippet(id string, draft model.SnippetDraft) {
+	snippet := m.mustFindSnippet(id)
+
+	if snippet.isSection() {
+		currentTitle := snippet.GetTitle()
+		m.writeSection(snippet, func(s fileSnippet) string {
+			matter := s.matter
+			_, hasTitleHeader := titleheader.ParseTitleFromHeader(draft.Content)
+			// the title header is missing in the content if it is hidden in the preview
+			if matter.Title != "" || (!hasTitleHeader && (s.title() != "" || draft.Title != currentTitle)) {
+				matter.Title = draft.Title
+			}
+			matter.Tags = draft.Tags
+			return formatSection(matter, s.hasFrontMatter, draft.Content)
+		})
+		return
+	}
+
+	contents := draft.Content
+	if _, ok := titleheader.ParseTitleFromHeader(contents); !ok && draft.Title != filepath.Base(snippet.path) {
+		contents = formatSnippet(contents, draft.Title)
+	}
+
+	m.system.WriteFile(snippet.path, []byte(contents))
+}
+
+// DeleteSnippet removes the file of the snippet. Within a file holding multiple snippets, only the snippet is removed.
+func (m Manager) DeleteSnippet(id string) {
+	snippet := m.mustFindSnippet(id)
+	if snippet.anchor != "" {
+		m.writeSection(snippet, func(fileSnippet) string { return "" })
+		return
+	}
+	m.system.Remove(snippet.path)
+}
+
+// RenameSnippet changes the title header of the snippet. If the snippet has no title header, its title is the file
+// name, so the file is renamed instead. The title of snippets with front matter or within a file holding multiple
+// snippets is stored as front matter.
+func (m Manager) RenameSnippet(id string, title string) {
+	snippet := m.mustFindSnippet(id)
+
+	if snippet.isSection() {
+		m.writeSection(snippet, func(s fileSnippet) string {
+			if _, ok := titleheader.ParseTitleFromHeader(s.body); ok && s.matter.Title == "" {
+				return formatSection(s.matter, s.hasFrontMatter, formatSnippet(pruneTitleHeaderKeepShebang(s.body), title))
+			}
+			matter := s.matter
+			matter.Title = title
+			return formatFrontMatter(matter, s.body)
+		})
+		return
+	}
+
+	filePath := snippet.path
+	contents := string(m.system.ReadFile(filePath))
+
+	if _, ok := titleheader.ParseTitleFromHeader(contents); ok {
+		m.system.WriteFile(filePath, []byte(formatSnippet(pruneTitleHeaderKeepShebang(contents), title)))
+		return
+	}
+
+	fileName := fileNameForTitle(title, LanguageForSuffix(filepath.Ext(filePath)))
+	if fileName == filepath.Base(filePath) {
+		return
+	}
+
+	newPath := m.availableFilePath(filepath.Dir(filePath), fileName)
+	if err := m.system.Fs.Rename(filePath, newPath); err != nil {
+		panic(errors.Wrapf(err, "failed to rename %s to %s", filePath, newPath))
+	}
+}
+
+func (m Manager) mustFindSnippet(id string) *snippetImpl {
+	for _, dir := range m.config.LibraryPath {
+		for _, snippet := range m.snippetsFromDir(dir, nil) {
+			if snippet.GetID() == id {
+				return snippet.(*snippetImpl)
+			}
+		}
+	}
+	panic(errors.Errorf("snippet not found: %s", id))
+}
+
+// writeSection replaces the section of the file which holds the snippet with the result of the format func. If the
+// result is empty, the section is removed. The file is removed if it does not hold any snippets afterward.
+func (m Manager) writeSection(snippet *snippetImpl, format func(fileSnippet) string) {
+	separator := m.config.SnippetSeparator
+	sections, fileSnippets := parseSnippetFile(string(m.system.ReadFile(snippet.path)), separator)
+
+	for _, s := range fileSnippets {
+		if s.anchor != snippet.anchor {
+			continue
+		}
+
+		if formatted := format(s); formatted == "" {
+			sections = slices.Delete(sections, s.index, s.index+1)
+		} else if len(fileSnippets) > 1 {
+			sections[s.index] = padSection(formatted, s.index)
+		} else {
+			sections[s.index] = formatted
+		}
+
+		contents := joinSections(sections, separator)
+		if strings.TrimSpace(contents) == "" {
+			m.system.Remove(snippet.path)
+		} else {
+			m.system.WriteFile(snippet.path, []byte(contents))
+		}
+		return
+	}
+
+	panic(errors.Errorf("snippet not found in %s: %s", snippet.path, snippet.anchor))
+}
+
+// formatNewSnippet adds the first tag of includeTags if the snippet would not be listed otherwise.
+func (m Manager) formatNewSnippet(title, content string, tags []string) string {
+	if validTags := stringutil.NewStringSet(m.config.IncludeTags); !tagutil.HasValidTag(validTags, tags) {
+		tags = append(slices.Clone(tags), m.config.IncludeTags[0])
+	}
+	if len(tags) > 0 {
+		return formatFrontMatter(frontMatter{Title: title, Tags: tags}, content)
+	}
+	return formatSnippet(content, title)
+}
+
+// padSection surrounds a section within a file holding multiple snippets with blank lines.
+func padSection(section string, index int) string {
+	section = strings.TrimRight(section, "\n") + "\n"
+	if index > 0 {
+		section = "\n" + section
+	}
+	return section
+}
+
+// availableFilePath returns a path for the file name within the directory which does not exist yet.
+func (m Manager) availableFilePath(dir, fileName string) string {
+	ext := filepath.Ext(fileName)
+	base := strings.TrimSuffix(fileName, ext)
+
+	result := filepath.Join(dir, fileName)
+	for i := 2; m.system.FileExists(result); i++ {
+		result = filepath.Join(dir, fmt.Sprintf("%s-%d%s", base, i, ext))
+	}
+	return result
+}
+
+func slugify(title string) string {
+	return strings.Trim(fileNameInvalidCharsRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+}
+
+func fileNameForTitle(title string, language model.Language) string {
+	name := slugify(title)
+	if name == "" {
+		name = "snippet"
+	}
+	return name + suffixForLanguage(language)
+}
+
+func suffixForLanguage(language model.Language) string {
+	suffixes := []string{
+		".sh", ".yaml", ".md", ".toml", ".py", ".rb", ".js", ".ps1", ".pl", ".sql", ".dockerfile", ".json",
+	}
+	for _, suffix := range suffixes {
+		if suffixLanguageMap[suffix] == language {
+			return suffix
+		}
+	}
+	return defaultSnippetSuffix
+}
+
+// pruneTitleHeaderKeepShebang removes the title header. Blank lines between a shebang line and the remaining content
+// are removed as well since formatSnippet adds them again.
+func pruneTitleHeaderKeepShebang(contents string) string {
+	pruned := titleheader.PruneTitleHeader(contents)
+	if shebang, rest, ok := strings.Cut(pruned, "\n"); ok && strings.HasPrefix(shebang, "#!/") {
+		return shebang + "\n" + strings.TrimLeft(rest, "\n")
+	}
+	return pruned
+}
+
+func (m Manager) Writable() bool {
+	return len(m.config.LibraryPath) > m.config.AssistantLibraryPathIndex
+}
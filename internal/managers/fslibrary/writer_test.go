@@ -0,0 +1,218 @@
+package fslibrary
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func newWriterTestManager(t *testing.T, configure ...func(*Config)) (*Manager, *system.System, string) {
+	t.Helper()
+	libraryPath := t.TempDir()
+	sys := testutil.NewTestSystem()
+	config := Config{Enabled: true, LibraryPath: []string{libraryPath}, SuffixRegex: []string{".sh", ".yaml"}}
+	for _, c := range configure {
+		c(&config)
+	}
+	manager, err := NewManager(WithSystem(sys), WithConfig(config))
+	assert.NoError(t, err)
+	return manager, sys, libraryPath
+}
+
+func Test_CreateSnippet(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t)
+	assert.True(t, manager.Writable())
+
+	id := manager.CreateSnippet(model.SnippetDraft{Title: "Hello World!", Content: "echo hello", Language: model.LanguageBash})
+	expectedPath := filepath.Join(libraryPath, "hello-world.sh")
+	assert.Equal(t, idutil.FormatSnippetID(expectedPath, idPrefix), id)
+	assert.Equal(t, "#\n# Hello World!\n#\n\necho hello", string(sys.ReadFile(expectedPath)))
+
+	id = manager.CreateSnippet(model.SnippetDraft{Title: "Hello World", Content: "foo: bar", Language: model.LanguageYAML})
+	assert.Equal(t, idutil.FormatSnippetID(filepath.Join(libraryPath, "hello-world.yaml"), idPrefix), id)
+
+	id = manager.CreateSnippet(model.SnippetDraft{Title: "Hello World", Content: "echo again"})
+	assert.Equal(t, idutil.FormatSnippetID(filepath.Join(libraryPath, "hello-world-2.sh"), idPrefix), id)
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 3)
+}
+
+func Test_UpdateSnippet(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t)
+
+	filePath := filepath.Join(libraryPath, "foo.sh")
+	sys.WriteFile(filePath, []byte("echo foo"))
+	id := idutil.FormatSnippetID(filePath, idPrefix)
+
+	manager.UpdateSnippet(id, model.SnippetDraft{Title: "foo.sh", Content: "echo bar"})
+	assert.Equal(t, "echo bar", string(sys.ReadFile(filePath)))
+
+	manager.UpdateSnippet(id, model.SnippetDraft{Title: "Foo", Content: "echo baz"})
+	assert.Equal(t, "#\n# Foo\n#\n\necho baz", string(sys.ReadFile(filePath)))
+
+	manager.UpdateSnippet(id, model.SnippetDraft{Title: "Foo", Content: "#\n# Other title\n#\necho baz"})
+	assert.Equal(t, "#\n# Other title\n#\necho baz", string(sys.ReadFile(filePath)))
+
+	assert.PanicsWithError(t, "snippet not found: unknown", func() {
+		manager.UpdateSnippet("unknown", model.SnippetDraft{})
+	})
+}
+
+func Test_DeleteSnippet(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t)
+
+	filePath := filepath.Join(libraryPath, "foo.sh")
+	sys.WriteFile(filePath, []byte("echo foo"))
+
+	manager.DeleteSnippet(idutil.FormatSnippetID(filePath, idPrefix))
+	assert.False(t, sys.FileExists(filePath))
+	assert.Empty(t, manager.GetSnippets())
+}
+
+func Test_RenameSnippet(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t)
+
+	withHeader := filepath.Join(libraryPath, "with-header.sh")
+	sys.WriteFile(withHeader, []byte("#!/bin/bash\n\n#\n# Old title\n#\n\necho foo\n"))
+	manager.RenameSnippet(idutil.FormatSnippetID(withHeader, idPrefix), "New title")
+	assert.Equal(t, "#!/bin/bash\n\n#\n# New title\n#\n\necho foo", string(sys.ReadFile(withHeader)))
+
+	withoutHeader := filepath.Join(libraryPath, "without-header.sh")
+	sys.WriteFile(withoutHeader, []byte("echo bar"))
+	manager.RenameSnippet(idutil.FormatSnippetID(withoutHeader, idPrefix), "Renamed snippet")
+	assert.False(t, sys.FileExists(withoutHeader))
+	assert.Equal(t, "echo bar", string(sys.ReadFile(filepath.Join(libraryPath, "renamed-snippet.sh"))))
+}
+
+func withSeparator(config *Config) {
+	config.SnippetSeparator = "# ---"
+}
+
+const testTopicFile = `---
+title: First
+---
+echo first
+
+# ---
+
+#
+# Second
+#
+echo second
+
+# ---
+
+echo third
+`
+
+func Test_CreateSnippet_WithTags(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t, func(config *Config) {
+		config.IncludeTags = []string{"snipkit"}
+	})
+
+	manager.CreateSnippet(model.SnippetDraft{Title: "Hello", Content: "echo hello", Tags: []string{"foo"}})
+	assert.Equal(
+		t,
+		"---\ntitle: Hello\ntags:\n  - foo\n  - snipkit\n---\necho hello",
+		string(sys.ReadFile(filepath.Join(libraryPath, "hello.sh"))),
+	)
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "Hello", snippets[0].GetTitle())
+	assert.Equal(t, []string{"foo", "snipkit"}, snippets[0].GetTags())
+}
+
+func Test_UpdateSnippet_WithinFile(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t, withSeparator)
+
+	filePath := filepath.Join(libraryPath, "topic.sh")
+	sys.WriteFile(filePath, []byte(testTopicFile))
+
+	manager.UpdateSnippet(
+		idutil.FormatSnippetID(filePath+"#first", idPrefix),
+		model.SnippetDraft{Title: "First", Tags: []string{"foo"}, Content: "echo 1"},
+	)
+	manager.UpdateSnippet(
+		idutil.FormatSnippetID(filePath+"#snippet-3", idPrefix),
+		model.SnippetDraft{Title: "Third", Content: "echo 3"},
+	)
+
+	assert.Equal(t, `---
+title: First
+tags:
+  - foo
+---
+echo 1
+
+# ---
+
+#
+# Second
+#
+echo second
+
+# ---
+
+---
+title: Third
+---
+echo 3
+`, string(sys.ReadFile(filePath)))
+}
+
+func Test_UpdateSnippet_KeepsFrontMatter(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t)
+
+	filePath := filepath.Join(libraryPath, "foo.sh")
+	sys.WriteFile(filePath, []byte("---\ntitle: Foo\ndescription: Prints foo\n---\necho foo"))
+
+	manager.UpdateSnippet(idutil.FormatSnippetID(filePath, idPrefix), model.SnippetDraft{Title: "Foo", Content: "echo bar"})
+	assert.Equal(t, "---\ntitle: Foo\ndescription: Prints foo\n---\necho bar", string(sys.ReadFile(filePath)))
+}
+
+func Test_DeleteSnippet_WithinFile(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t, withSeparator)
+
+	filePath := filepath.Join(libraryPath, "topic.sh")
+	sys.WriteFile(filePath, []byte(testTopicFile))
+
+	manager.DeleteSnippet(idutil.FormatSnippetID(filePath+"#second", idPrefix))
+	assert.Equal(t, "---\ntitle: First\n---\necho first\n\n# ---\n\necho third\n", string(sys.ReadFile(filePath)))
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, "topic.sh #2", snippets[1].GetTitle())
+
+	manager.DeleteSnippet(snippets[0].GetID())
+	remaining := manager.GetSnippets()
+	assert.Len(t, remaining, 1)
+	assert.Equal(t, idutil.FormatSnippetID(filePath, idPrefix), remaining[0].GetID())
+
+	manager.DeleteSnippet(remaining[0].GetID())
+	assert.False(t, sys.FileExists(filePath))
+}
+
+func Test_RenameSnippet_WithinFile(t *testing.T) {
+	manager, sys, libraryPath := newWriterTestManager(t, withSeparator)
+
+	filePath := filepath.Join(libraryPath, "topic.sh")
+	sys.WriteFile(filePath, []byte(testTopicFile))
+
+	manager.RenameSnippet(idutil.FormatSnippetID(filePath+"#first", idPrefix), "Renamed first")
+	manager.RenameSnippet(idutil.FormatSnippetID(filePath+"#second", idPrefix), "Renamed second")
+
+	titles := []string{}
+	for _, snippet := range manager.GetSnippets() {
+		titles = append(titles, snippet.GetTitle())
+	}
+	assert.Equal(t, []string{"Renamed first", "Renamed second", "topic.sh #3"}, titles)
+	assert.Contains(t, string(sys.ReadFile(filePath)), "#\n# Renamed second\n#\n\necho second")
+}
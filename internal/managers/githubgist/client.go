@@ -1,27 +1,47 @@
 package githubgist
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const (
+	maxRateLimitRetries  = 3
+	maxRateLimitWait     = time.Minute
+	defaultRateLimitWait = 10 * time.Second
 )
 
 var (
 	errAuth       = errors.New("github unauthorized")
 	errUnexpected = errors.New("unexpected status code from github")
+	errRateLimit  = errors.New("github rate limit exceeded")
+	errNotFound   = errors.New("github gist not found")
+
+	nextLinkRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
 )
 
+// syncReporter adds a line to the output of the current sync process.
+type syncReporter func(line model.SyncLine)
+
 type rawResponse struct {
 	hasUpdates    bool
 	etag          string
 	gistsResponse *[]rawGistsResponse
 	rawContent    *[]byte
+	nextURL       string
 }
 
 type rawGistsResponse struct {
@@ -31,9 +51,13 @@ type rawGistsResponse struct {
 		ContentType string `json:"type"`
 		Language    string `json:"language"`
 		RawURL      string `json:"raw_url"`
+		Content     string `json:"content"`
 	} `json:"files"`
-	Public      bool   `json:"public"`
-	Description string `json:"description"`
+	Public      bool      `json:"public"`
+	Description string    `json:"description"`
+	HTMLURL     string    `json:"html_url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
 }
 
 func (m Manager) checkToken(cfg GistConfig, token string) bool {
@@ -68,30 +92,136 @@ func (m Manager) checkToken(cfg GistConfig, token string) bool {
 	return true
 }
 
-func (m Manager) getGists(cfg GistConfig, etag, token string) rawResponse {
-	raw := m.getRawResponse(cfg.apiURL(), etag, token)
+// getGists retrieves all gists of the source described by the config. Only sources which consist of a single list of
+// gists support the ETag. All other sources are retrieved completely upon each sync.
+func (m Manager) getGists(cfg GistConfig, etag, token string, report syncReporter) rawResponse {
+	switch cfg.source() {
+	case GistSourceStarred:
+		if token == "" {
+			panic(errors.Errorf("Starred gists of %s require authentication", cfg.URL))
+		}
+		return m.getGistPages(cfg.starredPageURL(), etag, token, report)
+	case GistSourceIDs:
+		var result []rawGistsResponse
+		for _, id := range cfg.GistIDs {
+			if gist, ok := m.getGistByID(cfg, id, token, report); ok {
+				result = append(result, gist)
+			}
+		}
+		return rawResponse{hasUpdates: true, gistsResponse: &result}
+	case GistSourceUsers:
+		var result []rawGistsResponse
+		for _, user := range cfg.Users {
+			if resp := m.getGistPages(cfg.userPageURL(user), "", token, report); resp.gistsResponse != nil {
+				result = append(result, *resp.gistsResponse...)
+			}
+		}
+		return rawResponse{hasUpdates: true, gistsResponse: &result}
+	case GistSourceUser:
+		return m.getGistPages(cfg.gistsPageURL(), etag, token, report)
+	}
+
+	panic(errors.Errorf("unsupported gist source: %s", cfg.Source))
+}
+
+// getGistPages retrieves a list of gists page by page. The ETag only refers to the first page: if it has not been
+// modified, the following pages are assumed to be unchanged as well.
+func (m Manager) getGistPages(url, etag, token string, report syncReporter) rawResponse {
+	var result []rawGistsResponse
+
+	raw := m.getRawResponse(url, etag, token, report)
 	if !raw.hasUpdates {
 		return rawResponse{hasUpdates: false}
 	}
+	firstPageETag := raw.etag
 
-	var response []rawGistsResponse
-	err := json.Unmarshal(*raw.rawContent, &response)
-	if err != nil {
-		panic(err)
+	for page := 1; ; page++ {
+		var response []rawGistsResponse
+		if err := json.Unmarshal(*raw.rawContent, &response); err != nil {
+			panic(err)
+		}
+		result = append(result, response...)
+
+		if raw.nextURL == "" {
+			break
+		}
+
+		log.Trace().Msgf("Fetching page %d of gists: %s", page+1, raw.nextURL)
+		raw = m.getRawResponse(raw.nextURL, "", token, report)
 	}
 
 	return rawResponse{
 		hasUpdates:    true,
-		etag:          raw.etag,
-		gistsResponse: &response,
+		etag:          firstPageETag,
+		gistsResponse: &result,
 	}
 }
 
-func (m Manager) getRawGist(url, etag, token string) rawResponse {
-	return m.getRawResponse(url, etag, token)
+// getGist retrieves a single gist by its URL.
+func (m Manager) getGist(url, token string, report syncReporter) rawGistsResponse {
+	raw := m.getRawResponse(url, "", token, report)
+
+	var response rawGistsResponse
+	if raw.rawContent != nil {
+		if err := json.Unmarshal(*raw.rawContent, &response); err != nil {
+			panic(err)
+		}
+	}
+	return response
 }
 
-func (m Manager) getRawResponse(url, etag, token string) rawResponse {
+// getGistByID retrieves a single gist of the IDS source. A gist which does not exist (anymore) or is not accessible is
+// reported as error and skipped so that all other gists can still be synced.
+func (m Manager) getGistByID(cfg GistConfig, id, token string, report syncReporter) (result rawGistsResponse, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, isErr := r.(error)
+			if !isErr || !errors.Is(err, errNotFound) {
+				panic(r)
+			}
+
+			log.Warn().Err(err).Str("id", id).Msg("Skipping gist")
+			if report != nil {
+				report(model.SyncLine{Type: model.SyncLineTypeError, Value: fmt.Sprintf("Gist %s not found, skipping it", id)})
+			}
+			ok = false
+		}
+	}()
+
+	return m.getGist(cfg.gistURL(id), token, report), true
+}
+
+func (m Manager) getRawGist(url, etag, token string, report syncReporter) rawResponse {
+	return m.getRawResponse(url, etag, token, report)
+}
+
+// getRawResponse performs a GET request. If the rate limit of the GitHub API is exceeded, the request is retried after
+// the time indicated by GitHub as long as the waiting time is reasonable.
+func (m Manager) getRawResponse(url, etag, token string, report syncReporter) rawResponse {
+	for attempt := 0; ; attempt++ {
+		resp, retryAfter := m.doGet(url, etag, token)
+		if resp != nil {
+			return *resp
+		}
+
+		if attempt >= maxRateLimitRetries || retryAfter > maxRateLimitWait {
+			panic(errors.Wrapf(errRateLimit, "retry after %s", retryAfter.Round(time.Second)))
+		}
+
+		log.Info().Msgf("GitHub rate limit exceeded for %s, retry after %s", url, retryAfter)
+		if report != nil {
+			report(model.SyncLine{
+				Type:  model.SyncLineTypeInfo,
+				Value: fmt.Sprintf("GitHub API rate limit exceeded. Retrying in %s...", retryAfter.Round(time.Second)),
+			})
+		}
+		m.wait(retryAfter)
+	}
+}
+
+// doGet performs a single GET request. If the request was rejected due to the rate limit, no response is returned but
+// the duration to wait before the next attempt.
+func (m Manager) doGet(url, etag, token string) (*rawResponse, time.Duration) {
 	client := &http.Client{}
 	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
 	if err != nil {
@@ -108,18 +238,23 @@ func (m Manager) getRawResponse(url, etag, token string) rawResponse {
 	}
 
 	resp, err := client.Do(req)
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
 	if err != nil {
 		panic(err)
 	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
 
 	log.Trace().Msgf("Response status %s URL %s: %s", req.Method, url, resp.Status)
 
+	if retryAfter, limited := rateLimitRetryAfter(resp, time.Now()); limited {
+		return nil, retryAfter
+	}
+
 	if resp.StatusCode == http.StatusNotModified {
-		return rawResponse{hasUpdates: false}
+		return &rawResponse{hasUpdates: false}, 0
+	} else if resp.StatusCode == http.StatusNotFound {
+		panic(errors.Wrap(errNotFound, url))
 	} else if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
 		if payload, err2 := io.ReadAll(resp.Body); err2 != nil {
 			panic(err2)
@@ -131,12 +266,110 @@ func (m Manager) getRawResponse(url, etag, token string) rawResponse {
 	if bytes, err2 := io.ReadAll(resp.Body); err2 != nil {
 		panic(err2)
 	} else {
-		return rawResponse{
+		return &rawResponse{
 			hasUpdates: true,
 			rawContent: &bytes,
 			etag:       toStrongETag(resp.Header.Get("etag")),
+			nextURL:    nextPageURL(resp.Header.Get("Link")),
+		}, 0
+	}
+}
+
+func (m Manager) wait(d time.Duration) {
+	if m.sleep != nil {
+		m.sleep(d)
+	} else {
+		time.Sleep(d)
+	}
+}
+
+// rateLimitRetryAfter checks if the request was rejected due to the rate limit. The waiting time is taken from the
+// Retry-After header (secondary rate limit) or X-RateLimit-Reset header (primary rate limit).
+func rateLimitRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return 0, false
+	}
+
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		if d := time.Unix(reset, 0).Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return defaultRateLimitWait, true
+}
+
+// nextPageURL extracts the URL of the next page from the Link header, e.g.:
+// <https://api.github.com/user/1/gists?page=2>; rel="next", <https://api.github.com/user/1/gists?page=5>; rel="last".
+func nextPageURL(linkHeader string) string {
+	if matches := nextLinkRegex.FindStringSubmatch(linkHeader); len(matches) == 2 {
+		return matches[1]
+	}
+	return ""
+}
+
+// sendGistRequest creates, modifies or deletes a gist. The gist returned by the API is provided if the response has
+// any content.
+func (m Manager) sendGistRequest(method, url, token string, payload any) *rawGistsResponse {
+	var body io.Reader
+	if payload != nil {
+		if data, err := json.Marshal(payload); err != nil {
+			panic(err)
+		} else {
+			body = bytes.NewReader(data)
 		}
 	}
+
+	client := &http.Client{}
+	req, err := http.NewRequestWithContext(context.Background(), method, url, body)
+	if err != nil {
+		panic(err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("token %s", token))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	log.Trace().Msgf("Response status %s URL %s: %s", req.Method, url, resp.Status)
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		panic(err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		panic(errors.Wrap(errAuth, string(content)))
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		panic(errors.Wrapf(errUnexpected, "%s: %s", resp.Status, string(content)))
+	case len(content) == 0:
+		return nil
+	}
+
+	var response rawGistsResponse
+	if err = json.Unmarshal(content, &response); err != nil {
+		panic(err)
+	}
+	return &response
 }
 
 func toStrongETag(etag string) string {
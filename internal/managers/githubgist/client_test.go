@@ -3,12 +3,15 @@ package githubgist
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
 
+	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/assertutil"
 )
 
@@ -83,7 +86,7 @@ func Test_getRawGist(t *testing.T) {
 	manager := prepareGetRawResponse(path, "", 200, testNoResponseEtag, `foo: test`)
 
 	actualResponse := manager.getRawGist(
-		fmt.Sprintf("https://api.%s/%s", testHost, path), "etag-value", testToken,
+		fmt.Sprintf("https://api.%s/%s", testHost, path), "etag-value", testToken, nil,
 	)
 	assert.True(t, actualResponse.hasUpdates)
 	assert.NotNil(t, actualResponse.rawContent)
@@ -100,7 +103,7 @@ func Test_getRawGist_NotModified(t *testing.T) {
 	manager := prepareGetRawResponse(path, etagValue, http.StatusNotModified, testNoResponseEtag, `foo: test`)
 
 	actualResponse := manager.getRawGist(
-		fmt.Sprintf("https://api.%s/%s", testHost, path), etagValue, testToken,
+		fmt.Sprintf("https://api.%s/%s", testHost, path), etagValue, testToken, nil,
 	)
 	assert.False(t, actualResponse.hasUpdates)
 	assert.Nil(t, actualResponse.rawContent)
@@ -115,7 +118,7 @@ func Test_getRawGist_WeakEtag(t *testing.T) {
 	manager := prepareGetRawResponse(path, "", http.StatusOK, `W/"weaketag"`, "foo")
 
 	response := manager.getRawGist(
-		fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken,
+		fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken, nil,
 	)
 
 	assert.Equal(t, "weaketag", response.etag)
@@ -134,7 +137,7 @@ func Test_getRawGist_BadCredentials(t *testing.T) {
 
 	err := assertutil.AssertPanicsWithError(t, errAuth, func() {
 		manager.getRawGist(
-			fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken,
+			fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken, nil,
 		)
 	})
 
@@ -149,7 +152,7 @@ func Test_getRawGist_NoContent(t *testing.T) {
 	manager := prepareGetRawResponse(path, "", http.StatusNoContent, testNoResponseEtag, "")
 
 	response := manager.getRawGist(
-		fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken,
+		fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken, nil,
 	)
 
 	assert.NotNil(t, response.rawContent)
@@ -178,7 +181,7 @@ func Test_getRawGist_Error(t *testing.T) {
 
 	assert.Panics(t, func() {
 		manager.getRawGist(
-			fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken,
+			fmt.Sprintf("https://api.%s/%s", testHost, path), "", testToken, nil,
 		)
 	})
 }
@@ -197,7 +200,7 @@ func Test_getGists(t *testing.T) {
   }
 ]`)
 
-	response := manager.getGists(manager.config.Gists[0], testNoETag, testToken)
+	response := manager.getGists(manager.config.Gists[0], testNoETag, testToken, nil)
 
 	assert.True(t, response.hasUpdates)
 	assert.Nil(t, response.rawContent)
@@ -208,7 +211,7 @@ func Test_getGists(t *testing.T) {
 func Test_getGists_NoUpdates(t *testing.T) {
 	manager := prepareGetRawResponse(fmt.Sprintf("users/%s/gists", testUser), testNoETag, http.StatusNotModified, testNoResponseEtag, "")
 
-	response := manager.getGists(manager.config.Gists[0], testNoETag, testToken)
+	response := manager.getGists(manager.config.Gists[0], testNoETag, testToken, nil)
 	assert.False(t, response.hasUpdates)
 	assert.Nil(t, response.rawContent)
 	assert.Nil(t, response.gistsResponse, 1)
@@ -244,3 +247,198 @@ func prepareGetRawResponse(path, etag string, statusCode int, responseEtag, resp
 
 	return m
 }
+
+func Test_getGists_Pagination(t *testing.T) {
+	defer gock.Off()
+
+	cfg := Config{
+		Enabled: true,
+		Gists:   []GistConfig{{URL: testGistURL, AuthenticationMethod: AuthMethodPAT, PerPage: 1}},
+	}
+	manager, _ := NewManager(WithConfig(cfg))
+
+	gock.New(testAPIURL).
+		Get(fmt.Sprintf("users/%s/gists", testUser)).
+		MatchParam("per_page", "1").
+		Reply(http.StatusOK).
+		SetHeader("etag", "first_page_etag").
+		SetHeader("Link", fmt.Sprintf(`<%s/users/%s/gists?per_page=1&page=2>; rel="next", <%s/users/%s/gists?per_page=1&page=2>; rel="last"`, testAPIURL, testUser, testAPIURL, testUser)).
+		JSON(`[{"id": "gist1", "files": {}}]`)
+
+	gock.New(testAPIURL).
+		Get(fmt.Sprintf("users/%s/gists", testUser)).
+		MatchParam("page", "2").
+		Reply(http.StatusOK).
+		SetHeader("etag", "second_page_etag").
+		JSON(`[{"id": "gist2", "files": {}}]`)
+
+	response := manager.getGists(cfg.Gists[0], testNoETag, testToken, nil)
+
+	assert.True(t, gock.IsDone())
+	assert.True(t, response.hasUpdates)
+	assert.Equal(t, "first_page_etag", response.etag)
+	assert.Len(t, *response.gistsResponse, 2)
+	assert.Equal(t, "gist1", (*response.gistsResponse)[0].ID)
+	assert.Equal(t, "gist2", (*response.gistsResponse)[1].ID)
+}
+
+func Test_getRawResponse_RateLimitRetry(t *testing.T) {
+	defer gock.Off()
+
+	const path = "some/raw/gist-id"
+
+	gock.New(testAPIURL).Get(path).Reply(http.StatusTooManyRequests).SetHeader("Retry-After", "3")
+	gock.New(testAPIURL).Get(path).Reply(http.StatusOK).BodyString("foo")
+
+	var waited []time.Duration
+	var reported []model.SyncLine
+	manager := Manager{sleep: func(d time.Duration) { waited = append(waited, d) }}
+
+	response := manager.getRawResponse(fmt.Sprintf("%s/%s", testAPIURL, path), "", testToken, func(line model.SyncLine) {
+		reported = append(reported, line)
+	})
+
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, []byte("foo"), *response.rawContent)
+	assert.Equal(t, []time.Duration{3 * time.Second}, waited)
+	assert.Len(t, reported, 1)
+	assert.Equal(t, model.SyncLineTypeInfo, reported[0].Type)
+	assert.Contains(t, reported[0].Value, "rate limit")
+}
+
+func Test_getRawResponse_RateLimitExceeded(t *testing.T) {
+	defer gock.Off()
+
+	const path = "some/raw/gist-id"
+
+	gock.New(testAPIURL).Get(path).Reply(http.StatusForbidden).
+		SetHeader("X-RateLimit-Remaining", "0").
+		SetHeader("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+
+	manager := Manager{sleep: func(d time.Duration) { assert.Fail(t, "unexpected wait") }}
+
+	_ = assertutil.AssertPanicsWithError(t, errRateLimit, func() {
+		manager.getRawResponse(fmt.Sprintf("%s/%s", testAPIURL, path), "", testToken, nil)
+	})
+}
+
+func Test_rateLimitRetryAfter(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	tests := []struct {
+		name            string
+		status          int
+		headers         map[string]string
+		expectedLimited bool
+		expectedWait    time.Duration
+	}{
+		{name: "ok", status: http.StatusOK, headers: map[string]string{"X-RateLimit-Remaining": "0"}},
+		{name: "forbidden without rate limit", status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "10"}},
+		{name: "retry after", status: http.StatusForbidden, headers: map[string]string{"Retry-After": "30"}, expectedLimited: true, expectedWait: 30 * time.Second},
+		{name: "reset", status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1010"}, expectedLimited: true, expectedWait: 10 * time.Second},
+		{name: "reset in past", status: http.StatusTooManyRequests, headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "900"}, expectedLimited: true},
+		{name: "no reset", status: http.StatusTooManyRequests, headers: map[string]string{"X-RateLimit-Remaining": "0"}, expectedLimited: true, expectedWait: defaultRateLimitWait},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.headers {
+				resp.Header.Set(k, v)
+			}
+			wait, limited := rateLimitRetryAfter(resp, now)
+			assert.Equal(t, tt.expectedLimited, limited)
+			assert.Equal(t, tt.expectedWait, wait)
+		})
+	}
+}
+
+func Test_nextPageURL(t *testing.T) {
+	assert.Equal(t,
+		"https://api.github.com/user/1/gists?page=2",
+		nextPageURL(`<https://api.github.com/user/1/gists?page=2>; rel="next", <https://api.github.com/user/1/gists?page=5>; rel="last"`),
+	)
+	assert.Equal(t, "", nextPageURL(`<https://api.github.com/user/1/gists?page=1>; rel="prev"`))
+	assert.Equal(t, "", nextPageURL(""))
+}
+
+func Test_getGists_Starred(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		MatchHeader("Authorization", fmt.Sprintf("token %s", testToken)).
+		Get("gists/starred").
+		MatchParam("per_page", "100").
+		Reply(http.StatusOK).
+		SetHeader("etag", "starred_etag").
+		JSON(`[{"id": "starred1", "files": {}}]`)
+
+	cfg := GistConfig{URL: testGistURL, AuthenticationMethod: AuthMethodPAT, Source: GistSourceStarred}
+	response := Manager{}.getGists(cfg, testNoETag, testToken, nil)
+
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, "starred_etag", response.etag)
+	assert.Len(t, *response.gistsResponse, 1)
+	assert.Equal(t, "starred1", (*response.gistsResponse)[0].ID)
+
+	assert.Panics(t, func() {
+		Manager{}.getGists(GistConfig{URL: testGistURL, Source: GistSourceStarred}, testNoETag, "", nil)
+	})
+}
+
+func Test_getGists_IDs(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).Get("gists/id1").Reply(http.StatusOK).JSON(`{"id": "id1", "files": {}}`)
+	gock.New(testAPIURL).Get("gists/id2").Reply(http.StatusOK).JSON(`{"id": "id2", "files": {}}`)
+
+	cfg := GistConfig{URL: testGistURL, Source: GistSourceIDs, GistIDs: []string{"id1", "id2"}}
+	response := Manager{}.getGists(cfg, "some_etag", "", nil)
+
+	assert.True(t, gock.IsDone())
+	assert.True(t, response.hasUpdates)
+	assert.Empty(t, response.etag)
+	assert.Len(t, *response.gistsResponse, 2)
+	assert.Equal(t, "id1", (*response.gistsResponse)[0].ID)
+	assert.Equal(t, "id2", (*response.gistsResponse)[1].ID)
+}
+
+func Test_getGists_Users(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).Get("users/alice/gists").Reply(http.StatusOK).JSON(`[{"id": "alice1", "files": {}}]`)
+	gock.New(testAPIURL).Get("users/bob/gists").Reply(http.StatusOK).JSON(`[{"id": "bob1", "files": {}}, {"id": "bob2", "files": {}}]`)
+
+	cfg := GistConfig{URL: testGistURL, Source: GistSourceUsers, Users: []string{"alice", "bob"}}
+	response := Manager{}.getGists(cfg, "some_etag", "", nil)
+
+	assert.True(t, gock.IsDone())
+	assert.Empty(t, response.etag)
+	assert.Len(t, *response.gistsResponse, 3)
+}
+
+func Test_getGists_UnsupportedSource(t *testing.T) {
+	assert.Panics(t, func() {
+		Manager{}.getGists(GistConfig{URL: testGistURL, Source: "FOO"}, testNoETag, "", nil)
+	})
+}
+
+func Test_getGists_IDs_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).Get("gists/id1").Reply(http.StatusOK).JSON(`{"id": "id1", "files": {}}`)
+	gock.New(testAPIURL).Get("gists/deleted").Reply(http.StatusNotFound).JSON(`{"message": "Not Found"}`)
+	gock.New(testAPIURL).Get("gists/id2").Reply(http.StatusOK).JSON(`{"id": "id2", "files": {}}`)
+
+	var lines []model.SyncLine
+	report := func(line model.SyncLine) { lines = append(lines, line) }
+
+	cfg := GistConfig{URL: testGistURL, Source: GistSourceIDs, GistIDs: []string{"id1", "deleted", "id2"}}
+	response := Manager{}.getGists(cfg, "", "", report)
+
+	assert.True(t, gock.IsDone())
+	assert.Len(t, *response.gistsResponse, 2)
+	assert.Equal(t, "id1", (*response.gistsResponse)[0].ID)
+	assert.Equal(t, "id2", (*response.gistsResponse)[1].ID)
+	assert.Equal(t, []model.SyncLine{{Type: model.SyncLineTypeError, Value: "Gist deleted not found, skipping it"}}, lines)
+}
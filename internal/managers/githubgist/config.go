@@ -2,7 +2,9 @@ package githubgist
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
 
 	"emperror.dev/errors"
 )
@@ -10,6 +12,7 @@ import (
 type (
 	AuthMethod      string
 	SnippetNameMode string
+	GistSource      string
 )
 
 const (
@@ -17,13 +20,19 @@ const (
 	AuthMethodPAT             = AuthMethod("PAT")
 	AuthMethodOAuthDeviceFlow = AuthMethod("OAuthDeviceFlow")
 
-	apiURLPattern  = "https://api.%s/users/%s/gists"
-	hostURLPattern = "https://%s"
+	apiURLPattern      = "https://api.%s/users/%s/gists"
+	gistsAPIURLPattern = "https://api.%s/gists"
+	hostURLPattern     = "https://%s"
 
 	SnippetNameModeDescription              = "DESCRIPTION"
 	SnippetNameModeFilename                 = "FILENAME"
 	SnippetNameModeCombine                  = "COMBINE"
 	SnippetNameModeCombinePreferDescription = "COMBINE_PREFER_DESCRIPTION"
+
+	GistSourceUser    = GistSource("USER")
+	GistSourceStarred = GistSource("STARRED")
+	GistSourceIDs     = GistSource("IDS")
+	GistSourceUsers   = GistSource("USERS")
 )
 
 var urlRegex = regexp.MustCompile("^gist.(.*)/(.*)$")
@@ -44,6 +53,10 @@ type GistConfig struct {
 	RemoveTagsFromDescription bool            `yaml:"removeTagsFromDescription" head_comment:"If set to true, any tags will be removed from the description."`
 	TitleHeaderEnabled        bool            `yaml:"titleHeaderEnabled" head_comment:"If set to true, the snippet title can be overwritten by defining a title header within the gist."`
 	HideTitleInPreview        bool            `yaml:"hideTitleInPreview" head_comment:"If set to true, the title header comment will not be shown in the preview window."`
+	PerPage                   int             `yaml:"perPage,omitempty" head_comment:"Number of gists requested per page from the GitHub API (max. 100). Default value: 100."`
+	Source                    GistSource      `yaml:"source,omitempty" head_comment:"Defines which gists are provided. Supported values: USER (gists of the user of the url), STARRED (gists starred by the authenticated user), IDS (gists listed in gistIDs), USERS (public gists of the users listed in users). Default value: USER."`
+	GistIDs                   []string        `yaml:"gistIDs,omitempty" head_comment:"IDs of the gists to be provided if source is set to IDS."`
+	Users                     []string        `yaml:"users,omitempty" head_comment:"Users whose public gists are provided if source is set to USERS."`
 }
 
 func (g GistConfig) apiURL() string {
@@ -55,6 +68,78 @@ func (g GistConfig) apiURL() string {
 	return fmt.Sprintf(apiURLPattern, matches[1], matches[2])
 }
 
+// gistsAPIURL returns the URL of the endpoint used to create, modify and delete gists.
+func (g GistConfig) gistsAPIURL() string {
+	matches := urlRegex.FindStringSubmatch(g.URL)
+	const minMatches = 3
+	if len(matches) < minMatches {
+		panic(errors.Errorf("invalid gist url: %s", g.URL))
+	}
+	return fmt.Sprintf(gistsAPIURLPattern, matches[1])
+}
+
+// source returns the configured source. Gist configs created before sources were supported refer to the gists of the
+// user of the URL.
+func (g GistConfig) source() GistSource {
+	if g.Source == "" {
+		return GistSourceUser
+	}
+	return g.Source
+}
+
+// writable returns true if the gists of the config can be modified, which requires the source USER and authentication.
+func (g GistConfig) writable() bool {
+	return g.Enabled && g.source() == GistSourceUser && g.AuthenticationMethod != AuthMethodNone && g.AuthenticationMethod != ""
+}
+
+// storeKey identifies the cached gists of this config. It equals the URL for the default source so that existing
+// caches remain valid.
+func (g GistConfig) storeKey() string {
+	switch g.source() {
+	case GistSourceStarred:
+		return fmt.Sprintf("%s#starred", g.URL)
+	case GistSourceIDs:
+		return fmt.Sprintf("%s#ids:%s", g.URL, strings.Join(g.GistIDs, ","))
+	case GistSourceUsers:
+		return fmt.Sprintf("%s#users:%s", g.URL, strings.Join(g.Users, ","))
+	default:
+		return g.URL
+	}
+}
+
+// gistsPageURL returns the URL of the first page of gists of the configured user.
+func (g GistConfig) gistsPageURL() string {
+	return g.withPerPage(g.apiURL())
+}
+
+// starredPageURL returns the URL of the first page of gists starred by the authenticated user.
+func (g GistConfig) starredPageURL() string {
+	return g.withPerPage(fmt.Sprintf("%s/starred", g.gistsAPIURL()))
+}
+
+// userPageURL returns the URL of the first page of public gists of the given user.
+func (g GistConfig) userPageURL(user string) string {
+	matches := urlRegex.FindStringSubmatch(g.URL)
+	const minMatches = 3
+	if len(matches) < minMatches {
+		panic(errors.Errorf("invalid gist url: %s", g.URL))
+	}
+	return g.withPerPage(fmt.Sprintf(apiURLPattern, matches[1], url.PathEscape(user)))
+}
+
+// gistURL returns the URL of the gist with the given ID.
+func (g GistConfig) gistURL(id string) string {
+	return fmt.Sprintf("%s/%s", g.gistsAPIURL(), url.PathEscape(id))
+}
+
+func (g GistConfig) withPerPage(u string) string {
+	perPage := g.PerPage
+	if perPage <= 0 || perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	return fmt.Sprintf("%s?per_page=%d", u, perPage)
+}
+
 func (g GistConfig) hostURL() string {
 	matches := urlRegex.FindStringSubmatch(g.URL)
 	const minMatches = 3
@@ -64,9 +149,9 @@ func (g GistConfig) hostURL() string {
 	return fmt.Sprintf(hostURLPattern, matches[1])
 }
 
-func (c *Config) getGistConfig(url string) *GistConfig {
+func (c *Config) getGistConfig(storeKey string) *GistConfig {
 	for i := range c.Gists {
-		if c.Gists[i].URL == url {
+		if c.Gists[i].storeKey() == storeKey {
 			return &c.Gists[i]
 		}
 	}
@@ -38,3 +38,44 @@ func Test_config_invalidURL(t *testing.T) {
 		gistConfig.apiURL()
 	})
 }
+
+func Test_config_sources(t *testing.T) {
+	tests := []struct {
+		name             string
+		cfg              GistConfig
+		expectedStoreKey string
+	}{
+		{name: "default", cfg: GistConfig{URL: "gist.github.com/foo"}, expectedStoreKey: "gist.github.com/foo"},
+		{name: "user", cfg: GistConfig{URL: "gist.github.com/foo", Source: GistSourceUser}, expectedStoreKey: "gist.github.com/foo"},
+		{name: "starred", cfg: GistConfig{URL: "gist.github.com/foo", Source: GistSourceStarred}, expectedStoreKey: "gist.github.com/foo#starred"},
+		{
+			name:             "ids",
+			cfg:              GistConfig{URL: "gist.github.com/foo", Source: GistSourceIDs, GistIDs: []string{"a", "b"}},
+			expectedStoreKey: "gist.github.com/foo#ids:a,b",
+		},
+		{
+			name:             "users",
+			cfg:              GistConfig{URL: "gist.github.com/foo", Source: GistSourceUsers, Users: []string{"bar", "baz"}},
+			expectedStoreKey: "gist.github.com/foo#users:bar,baz",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedStoreKey, tt.cfg.storeKey())
+			cfg := Config{Gists: []GistConfig{{URL: "gist.github.com/foo", Source: GistSourceStarred}, tt.cfg}}
+			assert.Equal(t, tt.cfg.storeKey(), cfg.getGistConfig(tt.expectedStoreKey).storeKey())
+		})
+	}
+}
+
+func Test_config_urls(t *testing.T) {
+	gistConfig := GistConfig{URL: "gist.github.com/foo", PerPage: 30}
+	assert.Equal(t, "https://api.github.com/users/foo/gists?per_page=30", gistConfig.gistsPageURL())
+	assert.Equal(t, "https://api.github.com/gists/starred?per_page=30", gistConfig.starredPageURL())
+	assert.Equal(t, "https://api.github.com/users/bar/gists?per_page=30", gistConfig.userPageURL("bar"))
+	assert.Equal(t, "https://api.github.com/gists/abc", gistConfig.gistURL("abc"))
+
+	gistConfig.PerPage = 0
+	assert.Equal(t, "https://api.github.com/users/foo/gists?per_page=100", gistConfig.gistsPageURL())
+}
@@ -4,4 +4,7 @@ import "github.com/lemoony/snipkit/internal/utils/idutil"
 
 const (
 	idPrefix idutil.IDPrefix = "ghg"
+
+	maxPerPage             = 100
+	maxConcurrentDownloads = 8
 )
@@ -3,6 +3,9 @@ package githubgist
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"sync"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/cli/oauth"
@@ -29,6 +32,7 @@ type Manager struct {
 	suffixRegex []*regexp.Regexp //nolint:unused // ignore for now since not used yet
 	cache       cache.Cache
 	browseURL   func(s string) error
+	sleep       func(d time.Duration)
 }
 
 func NewManager(options ...Option) (*Manager, error) {
@@ -68,14 +72,18 @@ func (m Manager) Info() []model.InfoLine {
 func (m *Manager) GetSnippets() []model.Snippet {
 	var result []model.Snippet
 
+	// the same gist may be provided by multiple sources, e.g., if an own gist is starred
+	seen := stringutil.StringSet{}
+
 	if cacheStore := m.getStoreFromCache(); cacheStore != nil {
 		for _, gstore := range cacheStore.Gists {
 			if gistConfig := m.config.getGistConfig(gstore.URL); gistConfig != nil {
 				validTags := stringutil.NewStringSet(gistConfig.IncludeTags)
 				for _, raw := range gstore.RawSnippets {
 					snippet := parseSnippet(raw, *gistConfig)
-					if tagutil.HasValidTag(validTags, snippet.GetTags()) {
-						result = append(result, parseSnippet(raw, *gistConfig))
+					if tagutil.HasValidTag(validTags, snippet.GetTags()) && !seen.Contains(snippet.GetID()) {
+						seen.Add(snippet.GetID())
+						result = append(result, snippet)
 					}
 				}
 			}
@@ -102,10 +110,22 @@ func (m *Manager) Sync(events model.SyncEventChannel) {
 
 	events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
 
+	var linesMutex sync.Mutex
+	report := func(line model.SyncLine) {
+		linesMutex.Lock()
+		defer linesMutex.Unlock()
+		lines = append(lines, line)
+		events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+	}
+
 	currentStore := m.getStoreFromCache()
 	updatedStore := &store{Version: storeVersion}
 	for _, gistConfig := range m.config.Gists {
-		lines = append(lines, model.SyncLine{Type: model.SyncLineTypeInfo, Value: fmt.Sprintf("Checking %s", gistConfig.URL)})
+		checkMsg := fmt.Sprintf("Checking %s", gistConfig.URL)
+		if source := gistConfig.source(); source != GistSourceUser {
+			checkMsg = fmt.Sprintf("Checking %s (%s)", gistConfig.URL, strings.ToLower(string(source)))
+		}
+		lines = append(lines, model.SyncLine{Type: model.SyncLineTypeInfo, Value: checkMsg})
 
 		token, err := m.authToken(gistConfig, lines, events)
 		if err != nil {
@@ -117,7 +137,7 @@ func (m *Manager) Sync(events model.SyncEventChannel) {
 			currentGistStore = currentStore.getGists(gistConfig)
 		}
 
-		if s := m.getSnippetsFromAPI(gistConfig, token, currentGistStore); s != nil {
+		if s := m.getSnippetsFromAPI(gistConfig, token, currentGistStore, report); s != nil {
 			updatedStore.Gists = append(updatedStore.Gists, *s)
 		}
 	}
@@ -129,10 +149,6 @@ func (m *Manager) Sync(events model.SyncEventChannel) {
 	log.Trace().Msg("github gist sync finished")
 }
 
-func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
-	panic(errors.New("Not implemented"))
-}
-
 func (m *Manager) authToken(cfg GistConfig, lines []model.SyncLine, events model.SyncEventChannel) (string, error) {
 	switch cfg.AuthenticationMethod {
 	case AuthMethodNone:
@@ -256,54 +272,115 @@ func (m *Manager) requestOAuthToken(cfg GistConfig, lines []model.SyncLine, even
 	return accessToken.Token, nil
 }
 
-func (m *Manager) getSnippetsFromAPI(cfg GistConfig, token string, cache *gistStore) *gistStore {
+func (m *Manager) getSnippetsFromAPI(cfg GistConfig, token string, cache *gistStore, report syncReporter) *gistStore {
 	etag := ""
 	if cache != nil {
 		log.Debug().Msg("cached previous store available")
 		etag = cache.ETag
 	}
 
-	var snippets []rawSnippet
-	resp := m.getGists(cfg, etag, token)
+	resp := m.getGists(cfg, etag, token, report)
 
 	if !resp.hasUpdates {
 		return cache
 	}
 
+	var downloads []rawDownload
 	for _, gist := range *resp.gistsResponse {
 		for _, file := range gist.Files {
-			id := fmt.Sprintf("%s-%s", gist.ID, file.Filename)
-			fileETag := ""
-			var prevRawSnippet *rawSnippet
+			download := rawDownload{
+				url: file.RawURL,
+				snippet: rawSnippet{
+					ID:          rawSnippetID(gist.ID, file.Filename),
+					Filename:    file.Filename,
+					Pubic:       gist.Public,
+					Description: gist.Description,
+					Language:    file.Language,
+					FilesInGist: len(gist.Files),
+					URL:         gist.HTMLURL,
+					CreatedAt:   gist.CreatedAt,
+					UpdatedAt:   gist.UpdatedAt,
+				},
+			}
+
 			if cache != nil {
 				for i := range cache.RawSnippets {
-					if cache.RawSnippets[i].ID == id {
-						prevRawSnippet = &cache.RawSnippets[i]
-						fileETag = cache.RawSnippets[i].ETag
-						log.Trace().Msgf("Previous etag for %s: %s", id, fileETag)
+					if cache.RawSnippets[i].ID == download.snippet.ID {
+						download.previous = &cache.RawSnippets[i]
+						log.Trace().Msgf("Previous etag for %s: %s", download.snippet.ID, download.previous.ETag)
 						break
 					}
 				}
 			}
 
-			singleRawGistResp := m.getRawGist(file.RawURL, fileETag, token)
-
-			if !singleRawGistResp.hasUpdates {
-				snippets = append(snippets, *prevRawSnippet)
-			} else {
-				snippets = append(snippets, rawSnippet{
-					ID:          id,
-					Filename:    file.Filename,
-					Content:     *singleRawGistResp.rawContent,
-					Pubic:       gist.Public,
-					Description: gist.Description,
-					Language:    file.Language,
-					ETag:        singleRawGistResp.etag,
-					FilesInGist: len(gist.Files),
-				})
-			}
+			downloads = append(downloads, download)
 		}
 	}
 
-	return &gistStore{URL: cfg.URL, ETag: resp.etag, RawSnippets: snippets}
+	return &gistStore{URL: cfg.storeKey(), ETag: resp.etag, RawSnippets: m.downloadRawSnippets(downloads, token, report)}
+}
+
+// rawDownload describes a single gist file whose content has to be retrieved.
+type rawDownload struct {
+	url      string
+	snippet  rawSnippet
+	previous *rawSnippet
+}
+
+// downloadRawSnippets retrieves the contents of all gist files concurrently with a bounded number of workers. The
+// order of the returned snippets corresponds to the order of the downloads. If any download fails, the first panic is
+// propagated to the caller.
+func (m *Manager) downloadRawSnippets(downloads []rawDownload, token string, report syncReporter) []rawSnippet {
+	results := make([]rawSnippet, len(downloads))
+
+	var wg sync.WaitGroup
+	var panicOnce sync.Once
+	var panicValue any
+	semaphore := make(chan struct{}, maxConcurrentDownloads)
+
+	for i := range downloads {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicValue = r })
+				}
+				<-semaphore
+				wg.Done()
+			}()
+			results[i] = m.downloadRawSnippet(downloads[i], token, report)
+		}(i)
+	}
+
+	wg.Wait()
+
+	if panicValue != nil {
+		panic(panicValue)
+	}
+
+	return results
+}
+
+func (m *Manager) downloadRawSnippet(download rawDownload, token string, report syncReporter) rawSnippet {
+	fileETag := ""
+	if download.previous != nil {
+		fileETag = download.previous.ETag
+	}
+
+	resp := m.getRawGist(download.url, fileETag, token, report)
+
+	// the gist-level fields are always taken from the latest response, only the content may be taken from the cache
+	result := download.snippet
+	if !resp.hasUpdates && download.previous != nil {
+		result.Content = download.previous.Content
+		result.ETag = download.previous.ETag
+		return result
+	}
+
+	if resp.rawContent != nil {
+		result.Content = *resp.rawContent
+	}
+	result.ETag = resp.etag
+	return result
 }
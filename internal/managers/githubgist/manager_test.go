@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -75,6 +77,32 @@ func Test_GetSnippets(t *testing.T) {
 	assert.Equal(t, snippets[0].GetContent(), "foo")
 }
 
+func Test_GetSnippets_multipleSources(t *testing.T) {
+	ownConfig := GistConfig{Enabled: true, URL: testGistURL, NameMode: SnippetNameModeFilename, IncludeTags: []string{"foo"}}
+	starredConfig := GistConfig{Enabled: true, URL: testGistURL, Source: GistSourceStarred, NameMode: SnippetNameModeDescription}
+
+	testStore := &store{Version: storeVersion, Gists: []gistStore{
+		{URL: ownConfig.storeKey(), RawSnippets: []rawSnippet{
+			{ID: "own-a.sh", Filename: "a.sh", Description: "Own #foo", FilesInGist: 1},
+			{ID: "own-b.sh", Filename: "b.sh", Description: "Own #bar", FilesInGist: 1},
+		}},
+		{URL: starredConfig.storeKey(), RawSnippets: []rawSnippet{
+			{ID: "starred-c.sh", Filename: "c.sh", Description: "Starred #bar", FilesInGist: 1},
+			{ID: "own-a.sh", Filename: "a.sh", Description: "Own #foo", FilesInGist: 1},
+		}},
+	}}
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(testStore.serialize(), true)
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Gists: []GistConfig{ownConfig, starredConfig}}}
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, "a.sh", snippets[0].GetTitle())
+	assert.Equal(t, "Starred #bar", snippets[1].GetTitle())
+}
+
 // Scenario: Auth method is none.
 // Expected: No token check is required.
 func Test_Sync_noAuth(t *testing.T) {
@@ -365,10 +393,126 @@ func Test_Sync_ifNoneMatch_forSingleFile(t *testing.T) {
 	cacheMock.AssertCalled(t, "PutData", storeKey, updatedStore.serialize())
 }
 
-func Test_SaveAssistantSnippet(t *testing.T) {
-	assert.PanicsWithError(t, "Not implemented", func() {
-		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+// Scenario: The gist description was edited, but the content of the file did not change (status 304 for the raw file).
+// Expected: The gist-level fields are taken from the latest response, the content and etag of the file from the cache.
+func Test_Sync_ifNoneMatch_forUnchangedFileOfUpdatedGist(t *testing.T) {
+	defer gock.Off()
+
+	const updatedGistEtag = "etag_updated"
+
+	cachedStore := expectedStoreForTestData()
+	cachedStore.Gists[0].RawSnippets[0].Description = "Old description"
+	cachedStore.Gists[0].RawSnippets[0].Pubic = false
+	cachedStore.Gists[0].RawSnippets[0].URL = "https://gist.github.com/old"
+	cachedStore.Gists[0].RawSnippets[0].UpdatedAt = time.Date(2022, 1, 30, 8, 0, 0, 0, time.UTC)
+	cachedStore.Gists[0].RawSnippets[0].Content = []byte("cached content")
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(cachedStore.serialize(), true)
+	cacheMock.On("PutData", storeKey, mock.Anything).Return()
+
+	gock.New(fmt.Sprintf("https://api.%s", testHost)).
+		MatchHeader("If-None-Match", cachedStore.Gists[0].ETag).
+		Get(fmt.Sprintf("users/%s/gists", testUser)).
+		Reply(http.StatusOK).
+		SetHeader("etag", updatedGistEtag).
+		JSON(readTestdata(t, testDataGitHubDataPath))
+
+	gock.New(testGitHubRawURL).
+		Get("").
+		MatchHeader("If-None-Match", cachedStore.Gists[0].RawSnippets[0].ETag).
+		Reply(http.StatusNotModified)
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Gists: []GistConfig{
+		{Enabled: true, URL: testGistURL, AuthenticationMethod: AuthMethodNone},
+	}}}
+
+	eventChannel := make(model.SyncEventChannel)
+	go func() {
+		defer close(eventChannel)
+		manager.Sync(eventChannel)
+	}()
+
+	for event := range eventChannel {
+		t.Logf("Received event: %v\n", event)
+	}
+
+	updatedStore := expectedStoreForTestData()
+	updatedStore.Gists[0].ETag = updatedGistEtag
+	updatedStore.Gists[0].RawSnippets[0].Content = []byte("cached content")
+
+	assert.True(t, gock.IsDone())
+	cacheMock.AssertCalled(t, "PutData", storeKey, updatedStore.serialize())
+}
+
+// Scenario: The user has many gists with multiple files and the GitHub API rate limit is exceeded once.
+// Expected: All files are downloaded, the order is kept and the rate limit is reported as sync line.
+func Test_Sync_manyFilesAndRateLimit(t *testing.T) {
+	defer gock.Off()
+
+	const numberOfFiles = 20
+
+	files := map[string]any{}
+	for i := 0; i < numberOfFiles; i++ {
+		filename := fmt.Sprintf("file-%02d.sh", i)
+		files[filename] = map[string]string{
+			"filename": filename, "language": "Shell", "raw_url": fmt.Sprintf("https://gist.%s/raw/%s", testHost, filename),
+		}
+		gock.New(fmt.Sprintf("https://gist.%s", testHost)).
+			Get(fmt.Sprintf("raw/%s", filename)).
+			Reply(http.StatusOK).
+			BodyString(fmt.Sprintf("echo %d", i))
+	}
+
+	gock.New(testAPIURL).
+		Get(fmt.Sprintf("users/%s/gists", testUser)).
+		Reply(http.StatusTooManyRequests).
+		SetHeader("Retry-After", "1")
+	gock.New(testAPIURL).
+		Get(fmt.Sprintf("users/%s/gists", testUser)).
+		Reply(http.StatusOK).
+		JSON([]any{map[string]any{"id": "bigGist", "description": "Many files", "files": files}})
+
+	var updatedStore store
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(nil, false)
+	cacheMock.On("PutData", storeKey, mock.Anything).Run(func(args mock.Arguments) {
+		updatedStore.deserialize(args.Get(1).([]byte))
+	}).Return()
+
+	manager := &Manager{cache: &cacheMock, sleep: func(time.Duration) {}, config: Config{Enabled: true, Gists: []GistConfig{
+		{Enabled: true, URL: testGistURL, AuthenticationMethod: AuthMethodNone},
+	}}}
+
+	eventChannel := make(model.SyncEventChannel)
+
+	go func() {
+		defer close(eventChannel)
+		manager.Sync(eventChannel)
+	}()
+
+	var lastEvent model.SyncEvent
+	for event := range eventChannel {
+		lastEvent = event
+	}
+
+	assert.Equal(t, model.SyncStatusFinished, lastEvent.Status)
+	assert.Condition(t, func() bool {
+		for _, line := range lastEvent.Lines {
+			if strings.Contains(line.Value, "rate limit") {
+				return true
+			}
+		}
+		return false
 	})
+
+	assert.Len(t, updatedStore.Gists, 1)
+	assert.Len(t, updatedStore.Gists[0].RawSnippets, numberOfFiles)
+	for _, raw := range updatedStore.Gists[0].RawSnippets {
+		var index int
+		_, _ = fmt.Sscanf(raw.Filename, "file-%02d.sh", &index)
+		assert.Equal(t, fmt.Sprintf("echo %d", index), string(raw.Content))
+	}
 }
 
 func readTestdata(t *testing.T, path string) string {
@@ -395,6 +539,9 @@ func expectedStoreForTestData() *store {
 						Pubic:       true,
 						Language:    "Shell",
 						Content:     []byte("foo"),
+						URL:         "https://gist.github.com/testsnippetid",
+						CreatedAt:   time.Date(2022, 1, 29, 17, 52, 23, 0, time.UTC),
+						UpdatedAt:   time.Date(2022, 2, 11, 7, 15, 22, 0, time.UTC),
 					},
 				},
 			},
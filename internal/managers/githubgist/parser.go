@@ -17,15 +17,38 @@ var languageMapping = map[string]model.Language{
 	"Markdown": model.LanguageMarkdown,
 	"TOML":     model.LanguageTOML,
 	"YAML":     model.LanguageYAML,
+	"Text":     model.LanguageText,
+
+	"Python":     model.LanguagePython,
+	"Ruby":       model.LanguageRuby,
+	"JavaScript": model.LanguageJavaScript,
+	"PowerShell": model.LanguagePowerShell,
+	"Perl":       model.LanguagePerl,
+	"SQL":        model.LanguageSQL,
+	"Dockerfile": model.LanguageDockerfile,
+	"JSON":       model.LanguageJSON,
 }
 
 func parseSnippet(raw rawSnippet, cfg GistConfig) model.Snippet {
+	titleRaw := raw
+	if cfg.RemoveTagsFromDescription {
+		titleRaw.Description = pruneTags(raw.Description)
+	}
+
 	result := snippetImpl{
 		id:       idutil.FormatSnippetID(raw.ID, idPrefix),
 		tags:     parseTags(raw.Description),
-		title:    parseTitle(raw, cfg.NameMode, cfg.TitleHeaderEnabled),
+		title:    parseTitle(titleRaw, cfg.NameMode, cfg.TitleHeaderEnabled),
 		content:  formatContent(string(raw.Content), cfg.HideTitleInPreview),
 		language: mapLanguage(raw.Language),
+		metadata: model.SnippetMetadata{
+			Description: pruneTags(raw.Description),
+			Manager:     Key,
+			Source:      raw.URL,
+			Created:     raw.CreatedAt,
+			Modified:    raw.UpdatedAt,
+			ReadOnly:    !cfg.writable(),
+		},
 	}
 	return &result
 }
@@ -3,6 +3,7 @@ package githubgist
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -23,6 +24,9 @@ echo "Hello World"`),
 		Filename:    "echo-something.sh",
 		ETag:        "etag",
 		Description: "Echo something #test",
+		URL:         "https://gist.github.com/some",
+		CreatedAt:   time.Date(2022, 1, 29, 17, 52, 23, 0, time.UTC),
+		UpdatedAt:   time.Date(2022, 2, 11, 7, 15, 22, 0, time.UTC),
 	}
 
 	cfg := GistConfig{
@@ -38,6 +42,14 @@ echo "Hello World"`),
 	assert.Equal(t, `echo "Hello World"`, snippet.GetContent())
 	assert.Equal(t, model.LanguageBash, snippet.GetLanguage())
 	assert.Equal(t, []string{"test"}, snippet.GetTags())
+	assert.Equal(t, model.SnippetMetadata{
+		Description: "Echo something",
+		Manager:     Key,
+		Source:      raw.URL,
+		Created:     raw.CreatedAt,
+		Modified:    raw.UpdatedAt,
+		ReadOnly:    true,
+	}, snippet.GetMetadata())
 	assert.Empty(t, snippet.GetParameters())
 	assert.Equal(t, snippet.GetContent(), snippet.Format([]string{}, model.SnippetFormatOptions{}))
 }
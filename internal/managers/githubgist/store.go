@@ -2,6 +2,7 @@ package githubgist
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/phuslu/log"
 
@@ -19,20 +20,24 @@ type store struct {
 }
 
 type gistStore struct {
+	// URL refers to the store key of the gist config (see GistConfig.storeKey).
 	URL         string       `json:"url"`
 	ETag        string       `json:"ETag"`
 	RawSnippets []rawSnippet `json:"RawSnippets"`
 }
 
 type rawSnippet struct {
-	ID          string `json:"id"`
-	Filename    string `json:"filename"`
-	Content     []byte `json:"content"`
-	ETag        string `json:"etag"`
-	Pubic       bool   `json:"public"`
-	Description string `json:"description"`
-	Language    string `json:"language"`
-	FilesInGist int    `json:"filesInGist"`
+	ID          string    `json:"id"`
+	Filename    string    `json:"filename"`
+	Content     []byte    `json:"content"`
+	ETag        string    `json:"etag"`
+	Pubic       bool      `json:"public"`
+	Description string    `json:"description"`
+	Language    string    `json:"language"`
+	FilesInGist int       `json:"filesInGist"`
+	URL         string    `json:"url,omitempty"`
+	CreatedAt   time.Time `json:"createdAt,omitzero"`
+	UpdatedAt   time.Time `json:"updatedAt,omitzero"`
 }
 
 func (m *Manager) getStoreFromCache() *store {
@@ -63,7 +68,7 @@ func (c *store) deserialize(bytes []byte) {
 
 func (c *store) getGists(cfg GistConfig) *gistStore {
 	for i := range c.Gists {
-		if c.Gists[i].URL == cfg.URL {
+		if c.Gists[i].URL == cfg.storeKey() {
 			return &c.Gists[i]
 		}
 	}
@@ -0,0 +1,278 @@
+package githubgist
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+	"github.com/lemoony/snipkit/internal/utils/titleheader"
+)
+
+var (
+	errNoWritableGist  = errors.New("No enabled GitHub gist URL with authentication is configured")
+	errSnippetUnknown  = errors.New("The gist snippet is unknown. Please run 'snipkit sync' first")
+	errSnippetReadOnly = errors.New("Only gists of the source USER can be modified")
+
+	fileNameInvalidCharsRegex = regexp.MustCompile(`[^a-z0-9_.]+`)
+	tagInvalidCharsRegex      = regexp.MustCompile(`[\s#]+`)
+)
+
+var suffixForLanguage = map[model.Language]string{
+	model.LanguageBash:     ".sh",
+	model.LanguageYAML:     ".yaml",
+	model.LanguageMarkdown: ".md",
+	model.LanguageTOML:     ".toml",
+	model.LanguageText:     ".txt",
+
+	model.LanguagePython:     ".py",
+	model.LanguageRuby:       ".rb",
+	model.LanguageJavaScript: ".js",
+	model.LanguagePowerShell: ".ps1",
+	model.LanguagePerl:       ".pl",
+	model.LanguageSQL:        ".sql",
+	model.LanguageDockerfile: ".dockerfile",
+	model.LanguageJSON:       ".json",
+}
+
+type gistFileRequest struct {
+	Content string `json:"content"`
+}
+
+type gistRequest struct {
+	Description *string                     `json:"description,omitempty"`
+	Public      *bool                       `json:"public,omitempty"`
+	Files       map[string]*gistFileRequest `json:"files,omitempty"`
+}
+
+// gistSnippetRef references a single file of a gist which is known from the last sync.
+type gistSnippetRef struct {
+	cfg    GistConfig
+	gistID string
+	raw    rawSnippet
+}
+
+// Writable returns true if at least one enabled gist URL with source USER is configured with an authentication method.
+// Snippets are created for the first one of them.
+func (m Manager) Writable() bool {
+	_, ok := m.writableGistConfig()
+	return ok
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	cfg := m.mustWritableGistConfig()
+	m.createGist(cfg, snippetTitle, filename, string(contents), nil)
+}
+
+func (m Manager) CreateSnippet(draft model.SnippetDraft) string {
+	cfg := m.mustWritableGistConfig()
+	return m.createGist(cfg, draft.Title, fileNameForTitle(draft.Title, draft.Language), draft.Content, draft.Tags)
+}
+
+// UpdateSnippet modifies the content of the gist file. The description of the gist is only modified if title or tags
+// differ from the current ones, e.g., since the title of a snippet may be composed of description and filename.
+func (m Manager) UpdateSnippet(id string, draft model.SnippetDraft) {
+	ref := m.mustFindSnippet(id)
+	current := parseSnippet(ref.raw, ref.cfg)
+
+	title := pruneTags(ref.raw.Description)
+	if draft.Title != current.GetTitle() {
+		title = draft.Title
+	}
+
+	tags := parseTags(ref.raw.Description)
+	if !slices.Equal(draft.Tags, current.GetTags()) {
+		tags = draft.Tags
+	}
+
+	description := formatDescription(title, tags)
+	m.patchGist(ref, &gistRequest{
+		Description: &description,
+		Files:       map[string]*gistFileRequest{ref.raw.Filename: {Content: draft.Content}},
+	})
+}
+
+// DeleteSnippet deletes the gist if the snippet is its only file. Otherwise, only the file is removed from the gist.
+func (m Manager) DeleteSnippet(id string) {
+	ref := m.mustFindSnippet(id)
+	token := m.mustToken(ref.cfg)
+
+	if ref.raw.FilesInGist <= 1 {
+		m.sendGistRequest(http.MethodDelete, fmt.Sprintf("%s/%s", ref.cfg.gistsAPIURL(), ref.gistID), token, nil)
+		m.updateStore(ref.cfg, ref.gistID, nil)
+		return
+	}
+
+	m.patchGist(ref, &gistRequest{Files: map[string]*gistFileRequest{ref.raw.Filename: nil}})
+}
+
+// RenameSnippet rewrites the title header if the title is taken from it. Otherwise, the description is modified.
+func (m Manager) RenameSnippet(id string, title string) {
+	ref := m.mustFindSnippet(id)
+	content := string(ref.raw.Content)
+
+	if _, ok := titleheader.ParseTitleFromHeader(content); ok && ref.cfg.TitleHeaderEnabled {
+		content = fmt.Sprintf("#\n# %s\n#\n%s", title, titleheader.PruneTitleHeader(content))
+		m.patchGist(ref, &gistRequest{Files: map[string]*gistFileRequest{ref.raw.Filename: {Content: content}}})
+		return
+	}
+
+	description := formatDescription(title, parseTags(ref.raw.Description))
+	m.patchGist(ref, &gistRequest{Description: &description})
+}
+
+func (m Manager) createGist(cfg GistConfig, title, filename, content string, tags []string) string {
+	if validTags := stringutil.NewStringSet(cfg.IncludeTags); !tagutil.HasValidTag(validTags, tags) {
+		// otherwise the snippet would not be listed after creation
+		tags = append(slices.Clone(tags), cfg.IncludeTags[0])
+	}
+
+	description := formatDescription(title, tags)
+	public := false
+	gist := m.sendGistRequest(http.MethodPost, cfg.gistsAPIURL(), m.mustToken(cfg), &gistRequest{
+		Description: &description,
+		Public:      &public,
+		Files:       map[string]*gistFileRequest{filename: {Content: content}},
+	})
+	if gist == nil {
+		panic(errors.Wrap(errUnexpected, "no gist returned after creation"))
+	}
+
+	log.Debug().Str("gist", gist.ID).Str("url", cfg.URL).Msg("Created gist")
+	m.updateStore(cfg, gist.ID, gist)
+
+	return idutil.FormatSnippetID(rawSnippetID(gist.ID, filename), idPrefix)
+}
+
+func (m Manager) patchGist(ref gistSnippetRef, request *gistRequest) {
+	gist := m.sendGistRequest(
+		http.MethodPatch, fmt.Sprintf("%s/%s", ref.cfg.gistsAPIURL(), ref.gistID), m.mustToken(ref.cfg), request,
+	)
+	m.updateStore(ref.cfg, ref.gistID, gist)
+}
+
+// updateStore replaces all cached snippets of the gist with the files of the gist returned by the API. The ETag of the
+// gist list is reset so that the next sync retrieves all gists again.
+func (m Manager) updateStore(cfg GistConfig, gistID string, gist *rawGistsResponse) {
+	cacheStore := m.getStoreFromCache()
+	cacheStore.Version = storeVersion
+
+	gStore := cacheStore.getGists(cfg)
+	if gStore == nil {
+		cacheStore.Gists = append(cacheStore.Gists, gistStore{URL: cfg.storeKey()})
+		gStore = &cacheStore.Gists[len(cacheStore.Gists)-1]
+	}
+	gStore.ETag = ""
+
+	var snippets []rawSnippet
+	for _, raw := range gStore.RawSnippets {
+		if !strings.HasPrefix(raw.ID, gistID+"-") {
+			snippets = append(snippets, raw)
+		}
+	}
+
+	if gist != nil {
+		for _, file := range gist.Files {
+			snippets = append(snippets, rawSnippet{
+				ID:          rawSnippetID(gist.ID, file.Filename),
+				Filename:    file.Filename,
+				Content:     []byte(file.Content),
+				Pubic:       gist.Public,
+				Description: gist.Description,
+				Language:    file.Language,
+				FilesInGist: len(gist.Files),
+				URL:         gist.HTMLURL,
+				CreatedAt:   gist.CreatedAt,
+				UpdatedAt:   gist.UpdatedAt,
+			})
+		}
+	}
+
+	gStore.RawSnippets = snippets
+	m.storeInCache(cacheStore)
+}
+
+func (m Manager) writableGistConfig() (GistConfig, bool) {
+	for _, cfg := range m.config.Gists {
+		if cfg.writable() {
+			return cfg, true
+		}
+	}
+	return GistConfig{}, false
+}
+
+func (m Manager) mustWritableGistConfig() GistConfig {
+	if cfg, ok := m.writableGistConfig(); ok {
+		return cfg
+	}
+	panic(errNoWritableGist)
+}
+
+// mustToken returns the access token stored during the last sync since writing gists requires authentication.
+func (m Manager) mustToken(cfg GistConfig) string {
+	if cfg.AuthenticationMethod == AuthMethodNone {
+		panic(errors.Errorf("Writing gists for %s requires authentication", cfg.URL))
+	}
+	if token, ok := m.cache.GetSecret(secretKeyAccessToken, cfg.URL); ok {
+		return token
+	}
+	panic(errors.Errorf("No access token available for %s. Please run 'snipkit sync' first", cfg.URL))
+}
+
+// mustFindSnippet returns the snippet with the given ID. Only snippets of the gists of the authenticated user (source
+// USER) can be modified.
+func (m Manager) mustFindSnippet(id string) gistSnippetRef {
+	foundReadOnly := false
+	for _, gStore := range m.getStoreFromCache().Gists {
+		cfg := m.config.getGistConfig(gStore.URL)
+		if cfg == nil {
+			continue
+		}
+		for _, raw := range gStore.RawSnippets {
+			if idutil.FormatSnippetID(raw.ID, idPrefix) != id {
+				continue
+			}
+			if cfg.source() != GistSourceUser {
+				foundReadOnly = true
+				continue
+			}
+			gistID, _, _ := strings.Cut(raw.ID, "-")
+			return gistSnippetRef{cfg: *cfg, gistID: gistID, raw: raw}
+		}
+	}
+	if foundReadOnly {
+		panic(errors.Wrap(errSnippetReadOnly, id))
+	}
+	panic(errors.Wrap(errSnippetUnknown, id))
+}
+
+// formatDescription appends the tags to the title since gists do not support tags natively.
+func formatDescription(title string, tags []string) string {
+	parts := []string{strings.TrimSpace(title)}
+	for _, tag := range tags {
+		if tag = tagInvalidCharsRegex.ReplaceAllString(tag, "-"); tag != "" {
+			parts = append(parts, "#"+tag)
+		}
+	}
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+func rawSnippetID(gistID, filename string) string {
+	return fmt.Sprintf("%s-%s", gistID, filename)
+}
+
+func fileNameForTitle(title string, language model.Language) string {
+	name := strings.Trim(fileNameInvalidCharsRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if name == "" {
+		name = "snippet"
+	}
+	return name + stringutil.StringOrDefault(suffixForLanguage[language], ".sh")
+}
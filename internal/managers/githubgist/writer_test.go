@@ -0,0 +1,273 @@
+package githubgist
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/assertutil"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	mocks "github.com/lemoony/snipkit/mocks/cache"
+)
+
+const testGistResponse = `{
+  "id": "newgistid",
+  "description": "Say hello #snipkit",
+  "public": false,
+  "files": {
+    "say-hello.sh": {"filename": "say-hello.sh", "language": "Shell", "content": "echo hello"}
+  }
+}`
+
+func newWriterTestManager(t *testing.T, currentStore *store, cfg GistConfig) (*Manager, *store) {
+	t.Helper()
+
+	updatedStore := &store{}
+
+	cacheMock := mocks.Cache{}
+	if currentStore != nil {
+		cacheMock.On("GetData", storeKey).Return(currentStore.serialize(), true)
+	} else {
+		cacheMock.On("GetData", storeKey).Return(nil, false)
+	}
+	cacheMock.On("GetSecret", secretKeyAccessToken, testGistURL).Return(testToken, true)
+	cacheMock.On("PutData", storeKey, mock.Anything).Run(func(args mock.Arguments) {
+		updatedStore.deserialize(args.Get(1).([]byte))
+	}).Return()
+
+	return &Manager{cache: &cacheMock, config: Config{Enabled: true, Gists: []GistConfig{cfg}}}, updatedStore
+}
+
+func testWritableGistConfig() GistConfig {
+	return GistConfig{
+		Enabled:                   true,
+		URL:                       testGistURL,
+		AuthenticationMethod:      AuthMethodPAT,
+		IncludeTags:               []string{"snipkit"},
+		NameMode:                  SnippetNameModeCombinePreferDescription,
+		RemoveTagsFromDescription: true,
+	}
+}
+
+func Test_Writable(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      GistConfig
+		expected bool
+	}{
+		{name: "pat", cfg: GistConfig{Enabled: true, AuthenticationMethod: AuthMethodPAT}, expected: true},
+		{name: "oauth", cfg: GistConfig{Enabled: true, AuthenticationMethod: AuthMethodOAuthDeviceFlow}, expected: true},
+		{name: "no auth", cfg: GistConfig{Enabled: true, AuthenticationMethod: AuthMethodNone}, expected: false},
+		{name: "disabled", cfg: GistConfig{Enabled: false, AuthenticationMethod: AuthMethodPAT}, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := Manager{config: Config{Enabled: true, Gists: []GistConfig{tt.cfg}}}
+			assert.Equal(t, tt.expected, manager.Writable())
+		})
+	}
+}
+
+func Test_CreateSnippet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		MatchHeader("Authorization", fmt.Sprintf("token %s", testToken)).
+		Post("gists").
+		JSON(map[string]any{
+			"description": "Say hello #snipkit",
+			"public":      false,
+			"files":       map[string]any{"say-hello.sh": map[string]string{"content": "echo hello"}},
+		}).
+		Reply(http.StatusCreated).
+		BodyString(testGistResponse)
+
+	manager, updatedStore := newWriterTestManager(t, nil, testWritableGistConfig())
+
+	id := manager.CreateSnippet(model.SnippetDraft{Title: "Say hello", Content: "echo hello", Language: model.LanguageBash})
+
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, idutil.FormatSnippetID("newgistid-say-hello.sh", idPrefix), id)
+	assert.Len(t, updatedStore.Gists, 1)
+	assert.Equal(t, testGistURL, updatedStore.Gists[0].URL)
+	assert.Len(t, updatedStore.Gists[0].RawSnippets, 1)
+
+	snippet := parseSnippet(updatedStore.Gists[0].RawSnippets[0], testWritableGistConfig())
+	assert.Equal(t, id, snippet.GetID())
+	assert.Equal(t, "Say hello", snippet.GetTitle())
+	assert.Equal(t, []string{"snipkit"}, snippet.GetTags())
+	assert.Equal(t, "echo hello", snippet.GetContent())
+	assert.Equal(t, model.LanguageBash, snippet.GetLanguage())
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Post("gists").
+		JSON(map[string]any{
+			"description": "Say hello #snipkit",
+			"public":      false,
+			"files":       map[string]any{"say-hello.sh": map[string]string{"content": "echo hello"}},
+		}).
+		Reply(http.StatusCreated).
+		BodyString(testGistResponse)
+
+	manager, updatedStore := newWriterTestManager(t, nil, testWritableGistConfig())
+	manager.SaveAssistantSnippet("Say hello", "say-hello.sh", []byte("echo hello"))
+
+	assert.True(t, gock.IsDone())
+	assert.Len(t, updatedStore.Gists[0].RawSnippets, 1)
+}
+
+func Test_CreateSnippet_NoWritableGist(t *testing.T) {
+	manager, _ := newWriterTestManager(t, nil, GistConfig{Enabled: true, URL: testGistURL, AuthenticationMethod: AuthMethodNone})
+
+	_ = assertutil.AssertPanicsWithError(t, errNoWritableGist, func() {
+		manager.CreateSnippet(model.SnippetDraft{Title: "foo", Content: "echo foo"})
+	})
+}
+
+func Test_CreateSnippet_Unauthorized(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).Post("gists").Reply(http.StatusUnauthorized).BodyString(`{"message": "Bad credentials"}`)
+
+	manager, _ := newWriterTestManager(t, nil, testWritableGistConfig())
+
+	_ = assertutil.AssertPanicsWithError(t, errAuth, func() {
+		manager.CreateSnippet(model.SnippetDraft{Title: "foo", Content: "echo foo"})
+	})
+}
+
+func Test_UpdateSnippet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Patch("gists/testsnippetid").
+		JSON(map[string]any{
+			"description": "Echo Something #foo",
+			"files":       map[string]any{"test-file.sh": map[string]string{"content": "echo bar"}},
+		}).
+		Reply(http.StatusOK).
+		BodyString(`{"id": "testsnippetid", "description": "Echo Something #foo", "public": true, "files": {
+			"test-file.sh": {"filename": "test-file.sh", "language": "Shell", "content": "echo bar"}}}`)
+
+	cfg := testWritableGistConfig()
+	manager, updatedStore := newWriterTestManager(t, expectedStoreForTestData(), cfg)
+
+	id := idutil.FormatSnippetID("testsnippetid-test-file.sh", idPrefix)
+	manager.UpdateSnippet(id, model.SnippetDraft{Title: "Echo Something", Tags: []string{"foo"}, Content: "echo bar"})
+
+	assert.True(t, gock.IsDone())
+	assert.Empty(t, updatedStore.Gists[0].ETag)
+	assert.Len(t, updatedStore.Gists[0].RawSnippets, 1)
+	assert.Equal(t, "echo bar", parseSnippet(updatedStore.Gists[0].RawSnippets[0], cfg).GetContent())
+}
+
+func Test_RenameSnippet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Patch("gists/testsnippetid").
+		JSON(map[string]any{"description": "New title #foo"}).
+		Reply(http.StatusOK).
+		BodyString(`{"id": "testsnippetid", "description": "New title #foo", "public": true, "files": {
+			"test-file.sh": {"filename": "test-file.sh", "language": "Shell", "content": "foo"}}}`)
+
+	cfg := testWritableGistConfig()
+	manager, updatedStore := newWriterTestManager(t, expectedStoreForTestData(), cfg)
+
+	manager.RenameSnippet(idutil.FormatSnippetID("testsnippetid-test-file.sh", idPrefix), "New title")
+
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, "New title", parseSnippet(updatedStore.Gists[0].RawSnippets[0], cfg).GetTitle())
+}
+
+func Test_RenameSnippet_TitleHeader(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Patch("gists/testsnippetid").
+		JSON(map[string]any{
+			"files": map[string]any{"test-file.sh": map[string]string{"content": "#\n# New title\n#\necho foo"}},
+		}).
+		Reply(http.StatusOK)
+
+	currentStore := expectedStoreForTestData()
+	currentStore.Gists[0].RawSnippets[0].Content = []byte("#\n# Old title\n#\necho foo")
+
+	cfg := testWritableGistConfig()
+	cfg.TitleHeaderEnabled = true
+	manager, _ := newWriterTestManager(t, currentStore, cfg)
+
+	manager.RenameSnippet(idutil.FormatSnippetID("testsnippetid-test-file.sh", idPrefix), "New title")
+
+	assert.True(t, gock.IsDone())
+}
+
+func Test_DeleteSnippet(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).Delete("gists/testsnippetid").Reply(http.StatusNoContent)
+
+	manager, updatedStore := newWriterTestManager(t, expectedStoreForTestData(), testWritableGistConfig())
+	manager.DeleteSnippet(idutil.FormatSnippetID("testsnippetid-test-file.sh", idPrefix))
+
+	assert.True(t, gock.IsDone())
+	assert.Empty(t, updatedStore.Gists[0].RawSnippets)
+}
+
+func Test_DeleteSnippet_MultipleFiles(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Patch("gists/testsnippetid").
+		JSON(map[string]any{"files": map[string]any{"test-file.sh": nil}}).
+		Reply(http.StatusOK).
+		BodyString(`{"id": "testsnippetid", "description": "Echo Something #foo", "public": true, "files": {
+			"other.sh": {"filename": "other.sh", "language": "Shell", "content": "echo other"}}}`)
+
+	currentStore := expectedStoreForTestData()
+	currentStore.Gists[0].RawSnippets[0].FilesInGist = 2
+
+	manager, updatedStore := newWriterTestManager(t, currentStore, testWritableGistConfig())
+	manager.DeleteSnippet(idutil.FormatSnippetID("testsnippetid-test-file.sh", idPrefix))
+
+	assert.True(t, gock.IsDone())
+	assert.Len(t, updatedStore.Gists[0].RawSnippets, 1)
+	assert.Equal(t, "testsnippetid-other.sh", updatedStore.Gists[0].RawSnippets[0].ID)
+}
+
+func Test_UpdateSnippet_Unknown(t *testing.T) {
+	manager, _ := newWriterTestManager(t, expectedStoreForTestData(), testWritableGistConfig())
+
+	_ = assertutil.AssertPanicsWithError(t, errSnippetUnknown, func() {
+		manager.UpdateSnippet("unknown", model.SnippetDraft{})
+	})
+}
+
+func Test_formatDescription(t *testing.T) {
+	assert.Equal(t, "Title", formatDescription("Title", nil))
+	assert.Equal(t, "Title #foo #multi-word", formatDescription(" Title ", []string{"foo", "multi word", ""}))
+}
+
+func Test_UpdateSnippet_ReadOnlySource(t *testing.T) {
+	cfg := testWritableGistConfig()
+	cfg.Source = GistSourceStarred
+
+	currentStore := expectedStoreForTestData()
+	currentStore.Gists[0].URL = cfg.storeKey()
+
+	manager, _ := newWriterTestManager(t, currentStore, cfg)
+	assert.False(t, manager.Writable())
+
+	_ = assertutil.AssertPanicsWithError(t, errSnippetReadOnly, func() {
+		manager.UpdateSnippet(idutil.FormatSnippetID("testsnippetid-test-file.sh", idPrefix), model.SnippetDraft{})
+	})
+}
@@ -0,0 +1,220 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+)
+
+const (
+	headerPrivateToken = "PRIVATE-TOKEN"
+	headerNextPage     = "X-Next-Page"
+)
+
+var (
+	errAuth       = errors.New("gitlab unauthorized")
+	errUnexpected = errors.New("unexpected status code from gitlab")
+
+	nextLinkRegex = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+)
+
+type rawResponse struct {
+	hasUpdates       bool
+	etag             string
+	nextURL          string
+	snippetsResponse *[]rawSnippetsResponse
+	rawContent       *[]byte
+}
+
+type rawSnippetsResponse struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Visibility  string    `json:"visibility"`
+	FileName    string    `json:"file_name"`
+	RawURL      string    `json:"raw_url"`
+	WebURL      string    `json:"web_url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Files       []struct {
+		Path   string `json:"path"`
+		RawURL string `json:"raw_url"`
+	} `json:"files"`
+}
+
+type rawSnippetFile struct {
+	path   string
+	rawURL string
+}
+
+// snippetFiles returns all files of the snippet. Older GitLab versions only support a single file per snippet.
+func (r rawSnippetsResponse) snippetFiles() []rawSnippetFile {
+	if len(r.Files) == 0 {
+		return []rawSnippetFile{{path: r.FileName, rawURL: r.RawURL}}
+	}
+
+	result := make([]rawSnippetFile, len(r.Files))
+	for i, f := range r.Files {
+		result[i] = rawSnippetFile{path: f.Path, rawURL: f.RawURL}
+	}
+	return result
+}
+
+func (m Manager) checkToken(cfg InstanceConfig, token string) bool {
+	userURL := fmt.Sprintf("%s/user", cfg.apiURL())
+
+	client := &http.Client{}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, userURL, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	if token != "" {
+		req.Header.Set(headerPrivateToken, token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	log.Trace().Msgf("Response status GET URL %s: %s", userURL, resp.Status)
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return false
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		panic(errors.Wrap(errUnexpected, resp.Status))
+	}
+
+	return true
+}
+
+// getSnippets retrieves all snippets of the source page by page. The ETag only refers to the first page and the list
+// is not ordered by the time of the last update, so a change on a following page does not modify the first page.
+// Therefore, an ETag is only returned if all snippets fit on a single page.
+func (m Manager) getSnippets(cfg InstanceConfig, source, etag, token string) rawResponse {
+	raw := m.getRawResponse(cfg.sourceURL(source), etag, token)
+	if !raw.hasUpdates {
+		return rawResponse{hasUpdates: false}
+	}
+
+	resultETag := raw.etag
+	if raw.nextURL != "" {
+		resultETag = ""
+	}
+
+	var result []rawSnippetsResponse
+	for page := 1; ; page++ {
+		var response []rawSnippetsResponse
+		if err := json.Unmarshal(*raw.rawContent, &response); err != nil {
+			panic(err)
+		}
+		result = append(result, response...)
+
+		if raw.nextURL == "" {
+			break
+		}
+
+		log.Trace().Msgf("Fetching page %d of snippets: %s", page+1, raw.nextURL)
+		raw = m.getRawResponse(raw.nextURL, "", token)
+	}
+
+	return rawResponse{
+		hasUpdates:       true,
+		etag:             resultETag,
+		snippetsResponse: &result,
+	}
+}
+
+func (m Manager) getRawSnippet(url, etag, token string) rawResponse {
+	return m.getRawResponse(url, etag, token)
+}
+
+func (m Manager) getRawResponse(url, etag, token string) rawResponse {
+	client := &http.Client{}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	if token != "" {
+		req.Header.Set(headerPrivateToken, token)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", fmt.Sprintf(`"%s"`, etag))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	log.Trace().Msgf("Response status %s URL %s: %s", req.Method, url, resp.Status)
+
+	if resp.StatusCode == http.StatusNotModified {
+		return rawResponse{hasUpdates: false}
+	} else if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		panic(errors.Wrap(errAuth, resp.Status))
+	} else if resp.StatusCode != http.StatusOK {
+		if payload, err2 := io.ReadAll(resp.Body); err2 != nil {
+			panic(err2)
+		} else {
+			panic(errors.Wrapf(errUnexpected, "%s: %s", resp.Status, string(payload)))
+		}
+	}
+
+	if bytes, err2 := io.ReadAll(resp.Body); err2 != nil {
+		panic(err2)
+	} else {
+		return rawResponse{
+			hasUpdates: true,
+			rawContent: &bytes,
+			etag:       toStrongETag(resp.Header.Get("etag")),
+			nextURL:    nextPageURL(url, resp.Header),
+		}
+	}
+}
+
+// nextPageURL returns the URL of the next page. The Link header is preferred. Since GitLab omits it for large result
+// sets, the X-Next-Page header is used as fallback. An empty string is returned for the last page.
+func nextPageURL(requestURL string, header http.Header) string {
+	if matches := nextLinkRegex.FindStringSubmatch(header.Get("Link")); len(matches) == 2 {
+		return matches[1]
+	}
+
+	nextPage := header.Get(headerNextPage)
+	if nextPage == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(requestURL)
+	if err != nil {
+		panic(err)
+	}
+	query := parsed.Query()
+	query.Set("page", nextPage)
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+func toStrongETag(etag string) string {
+	etag = strings.TrimPrefix(etag, "W/")
+	return strings.Trim(etag, `"`)
+}
@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/h2non/gock.v1"
+)
+
+func Test_getSnippets_pagination(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Get("/snippets").
+		MatchParam("per_page", "100").
+		Reply(http.StatusOK).
+		SetHeader("etag", `W/"first_page"`).
+		SetHeader("Link", `<`+testAPIURL+`/snippets?page=2&per_page=100>; rel="next"`).
+		JSON(`[{"id": 1, "title": "first"}]`)
+
+	gock.New(testAPIURL).
+		Get("/snippets").
+		MatchParam("page", "2").
+		Reply(http.StatusOK).
+		SetHeader("etag", `W/"second_page"`).
+		SetHeader(headerNextPage, "3").
+		JSON(`[{"id": 2, "title": "second"}]`)
+
+	gock.New(testAPIURL).
+		Get("/snippets").
+		MatchParam("page", "3").
+		Reply(http.StatusOK).
+		JSON(`[{"id": 3, "title": "third"}]`)
+
+	resp := Manager{}.getSnippets(InstanceConfig{Host: testHost}, sourcePersonal, "", "")
+
+	assert.True(t, gock.IsDone())
+	assert.True(t, resp.hasUpdates)
+	assert.Empty(t, resp.etag)
+	assert.Len(t, *resp.snippetsResponse, 3)
+	assert.Equal(t, "third", (*resp.snippetsResponse)[2].Title)
+}
+
+func Test_getSnippets_singlePage(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(testAPIURL).
+		Get("/snippets").
+		MatchParam("per_page", "100").
+		Reply(http.StatusOK).
+		SetHeader("etag", `W/"first_page"`).
+		JSON(`[{"id": 1, "title": "first"}]`)
+
+	resp := Manager{}.getSnippets(InstanceConfig{Host: testHost}, sourcePersonal, "", "")
+
+	assert.True(t, gock.IsDone())
+	assert.Equal(t, "first_page", resp.etag)
+	assert.Len(t, *resp.snippetsResponse, 1)
+}
+
+func Test_nextPageURL(t *testing.T) {
+	header := http.Header{}
+	assert.Equal(t, "", nextPageURL(testAPIURL+"/snippets?per_page=100", header))
+
+	header.Set(headerNextPage, "2")
+	assert.Equal(t, testAPIURL+"/snippets?page=2&per_page=100", nextPageURL(testAPIURL+"/snippets?per_page=100", header))
+
+	header.Set("Link", `<https://gitlab.test/next>; rel="next", <https://gitlab.test/last>; rel="last"`)
+	assert.Equal(t, "https://gitlab.test/next", nextPageURL(testAPIURL+"/snippets?per_page=100", header))
+}
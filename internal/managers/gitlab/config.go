@@ -0,0 +1,99 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type (
+	AuthMethod      string
+	SnippetNameMode string
+)
+
+const (
+	AuthMethodNone = AuthMethod("None")
+	AuthMethodPAT  = AuthMethod("PAT")
+
+	apiURLPattern = "https://%s/api/v4"
+
+	SnippetNameModeTitle              = "TITLE"
+	SnippetNameModeFilename           = "FILENAME"
+	SnippetNameModeCombine            = "COMBINE"
+	SnippetNameModeCombinePreferTitle = "COMBINE_PREFER_TITLE"
+
+	sourcePersonal = "personal"
+	sourceProject  = "project:"
+)
+
+type Config struct {
+	Enabled   bool             `yaml:"enabled" head_comment:"If set to false, GitLab is disabled completely."`
+	Instances []InstanceConfig `yaml:"instances" head_comment:"You can define multiple independent GitLab sources (e.g., gitlab.com and a self-hosted instance)."`
+}
+
+type InstanceConfig struct {
+	Enabled              bool            `yaml:"enabled" head_comment:"If set to false, this GitLab instance is ignored."`
+	Host                 string          `yaml:"host" head_comment:"Host of the GitLab instance, e.g. gitlab.com or gitlab.example.org."`
+	AuthenticationMethod AuthMethod      `yaml:"authenticationMethod" head_comment:"Supported values: None, PAT. Default value: None (which means no authentication). In order to retrieve personal or private snippets, you must be authenticated."`
+	PersonalSnippets     bool            `yaml:"personalSnippets" head_comment:"If set to true, the personal snippets of the authenticated user will be provided to you."`
+	Projects             []string        `yaml:"projects" head_comment:"List of projects (full path like group/project or numeric ID) whose snippets should be provided to you."`
+	IncludeTags          []string        `yaml:"includeTags" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you. Tags are defined as #tag in the title or description of a snippet."`
+	SuffixRegex          []string        `yaml:"suffixRegex" head_comment:"Only snippet files with endings which match one of the listed suffixes will be considered."`
+	NameMode             SnippetNameMode `yaml:"nameMode" head_comment:"Defines where the snippet name is extracted from (see also titleHeaderEnabled). Allowed values: TITLE, FILENAME, COMBINE, COMBINE_PREFER_TITLE."`
+	RemoveTagsFromTitle  bool            `yaml:"removeTagsFromTitle" head_comment:"If set to true, any tags will be removed from the snippet title."`
+	TitleHeaderEnabled   bool            `yaml:"titleHeaderEnabled" head_comment:"If set to true, the snippet title can be overwritten by defining a title header within the snippet."`
+	HideTitleInPreview   bool            `yaml:"hideTitleInPreview" head_comment:"If set to true, the title header comment will not be shown in the preview window."`
+}
+
+func (i InstanceConfig) apiURL() string {
+	return fmt.Sprintf(apiURLPattern, strings.TrimSuffix(i.Host, "/"))
+}
+
+// sources returns the identifiers of all snippet lists configured for the instance.
+func (i InstanceConfig) sources() []string {
+	var result []string
+	if i.PersonalSnippets {
+		result = append(result, sourcePersonal)
+	}
+	for _, project := range i.Projects {
+		result = append(result, sourceProject+project)
+	}
+	return result
+}
+
+func (i InstanceConfig) sourceURL(source string) string {
+	if strings.HasPrefix(source, sourceProject) {
+		project := strings.TrimPrefix(source, sourceProject)
+		return fmt.Sprintf("%s/projects/%s/snippets?per_page=%d", i.apiURL(), url.PathEscape(project), perPage)
+	}
+	return fmt.Sprintf("%s/snippets?per_page=%d", i.apiURL(), perPage)
+}
+
+func (c *Config) getInstanceConfig(host string) *InstanceConfig {
+	for i := range c.Instances {
+		if c.Instances[i].Host == host {
+			return &c.Instances[i]
+		}
+	}
+	return nil
+}
+
+func AutoDiscoveryConfig() *Config {
+	return &Config{
+		Enabled: false,
+		Instances: []InstanceConfig{
+			{
+				Enabled:              false,
+				Host:                 "gitlab.com",
+				AuthenticationMethod: AuthMethodPAT,
+				PersonalSnippets:     true,
+				Projects:             []string{},
+				IncludeTags:          []string{},
+				NameMode:             SnippetNameModeCombinePreferTitle,
+				TitleHeaderEnabled:   true,
+				HideTitleInPreview:   true,
+				RemoveTagsFromTitle:  true,
+			},
+		},
+	}
+}
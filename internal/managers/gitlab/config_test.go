@@ -0,0 +1,32 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	cfg := AutoDiscoveryConfig()
+	assert.NotNil(t, cfg)
+	assert.False(t, cfg.Enabled)
+	assert.Len(t, cfg.Instances, 1)
+
+	instanceConfig := cfg.Instances[0]
+	assert.Equal(t, "gitlab.com", instanceConfig.Host)
+	assert.Equal(t, "https://gitlab.com/api/v4", instanceConfig.apiURL())
+
+	assert.Equal(t, &instanceConfig, cfg.getInstanceConfig("gitlab.com"))
+	assert.Nil(t, cfg.getInstanceConfig("gitlab.example.org"))
+}
+
+func Test_sources(t *testing.T) {
+	cfg := InstanceConfig{Host: "gitlab.example.org/", PersonalSnippets: true, Projects: []string{"group/sub/project", "13"}}
+
+	sources := cfg.sources()
+	assert.Equal(t, []string{sourcePersonal, "project:group/sub/project", "project:13"}, sources)
+
+	assert.Equal(t, "https://gitlab.example.org/api/v4/snippets?per_page=100", cfg.sourceURL(sources[0]))
+	assert.Equal(t, "https://gitlab.example.org/api/v4/projects/group%2Fsub%2Fproject/snippets?per_page=100", cfg.sourceURL(sources[1]))
+	assert.Equal(t, "https://gitlab.example.org/api/v4/projects/13/snippets?per_page=100", cfg.sourceURL(sources[2]))
+}
@@ -0,0 +1,9 @@
+package gitlab
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	perPage = 100
+
+	idPrefix idutil.IDPrefix = "gl"
+)
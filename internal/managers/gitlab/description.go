@@ -0,0 +1,14 @@
+package gitlab
+
+import "github.com/lemoony/snipkit/internal/model"
+
+var Key = model.ManagerKey("GitLab")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "GitLab Snippets",
+		Description: "Use personal and project snippets from gitlab.com or a self-hosted GitLab instance",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
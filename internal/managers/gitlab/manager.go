@@ -0,0 +1,268 @@
+package gitlab
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+const secretKeyAccessToken = cache.SecretKey("GitLab Access Token")
+
+var errAbort = errors.New("abort")
+
+type Manager struct {
+	system *system.System
+	config Config
+	cache  cache.Cache
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "GitLab enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "GitLab number of instances",
+		Value:   fmt.Sprintf("%d", len(m.config.Instances)),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "GitLab total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+
+	if cacheStore := m.getStoreFromCache(); cacheStore != nil {
+		for _, sstore := range cacheStore.Sources {
+			instanceConfig := m.config.getInstanceConfig(sstore.Host)
+			if instanceConfig == nil || !instanceConfig.Enabled {
+				continue
+			}
+
+			validTags := stringutil.NewStringSet(instanceConfig.IncludeTags)
+			suffixRegex := compileSuffixRegex(instanceConfig.SuffixRegex)
+			for _, raw := range sstore.RawSnippets {
+				if !checkSuffix(raw.Filename, suffixRegex) {
+					continue
+				}
+				if snippet := parseSnippet(raw, *instanceConfig); tagutil.HasValidTag(validTags, snippet.GetTags()) {
+					result = append(result, snippet)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (m *Manager) Sync(events model.SyncEventChannel) {
+	var lines []model.SyncLine
+	log.Trace().Msg("gitlab sync started")
+
+	defer func() {
+		if panicValue := recover(); panicValue != nil {
+			err := errors.Errorf("Sync failed: %s", panicValue)
+			log.Error().Err(err).Msg("Sync failed")
+			events <- model.SyncEvent{
+				Status: model.SyncStatusAborted,
+				Lines:  append(lines, model.SyncLine{Type: model.SyncLineTypeError, Value: err.Error()}),
+			}
+		}
+	}()
+
+	events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+
+	currentStore := m.getStoreFromCache()
+	updatedStore := &store{Version: storeVersion}
+	for _, instanceConfig := range m.config.Instances {
+		if !instanceConfig.Enabled {
+			continue
+		}
+
+		lines = append(lines, model.SyncLine{Type: model.SyncLineTypeInfo, Value: fmt.Sprintf("Checking %s", instanceConfig.Host)})
+
+		token, err := m.authToken(instanceConfig, lines, events)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, source := range instanceConfig.sources() {
+			var currentSourceStore *sourceStore
+			if currentStore != nil {
+				currentSourceStore = currentStore.getSource(instanceConfig.Host, source)
+			}
+
+			if s := m.getSnippetsFromAPI(instanceConfig, source, token, currentSourceStore); s != nil {
+				updatedStore.Sources = append(updatedStore.Sources, *s)
+			}
+		}
+	}
+
+	events <- model.SyncEvent{Status: model.SyncStatusFinished, Lines: lines}
+
+	m.storeInCache(updatedStore)
+
+	log.Trace().Msg("gitlab sync finished")
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+func (m *Manager) authToken(cfg InstanceConfig, lines []model.SyncLine, events model.SyncEventChannel) (string, error) {
+	switch cfg.AuthenticationMethod {
+	case AuthMethodNone, "":
+		return "", nil
+	case AuthMethodPAT:
+		return m.requestPAT(cfg, lines, events)
+	}
+
+	panic(errors.Errorf("unsupported auth method: %s", cfg.AuthenticationMethod))
+}
+
+func (m *Manager) requestPAT(cfg InstanceConfig, lines []model.SyncLine, events model.SyncEventChannel) (string, error) {
+	contChannel := make(chan model.SyncInputResult)
+
+	if token, tokenFound := m.cache.GetSecret(secretKeyAccessToken, cfg.Host); tokenFound {
+		if tokenOK := m.checkToken(cfg, token); tokenOK {
+			return token, nil
+		} else {
+			log.Info().Msgf("Stored token for %s is invalid. Delete it.", cfg.Host)
+			m.cache.DeleteSecret(secretKeyAccessToken, cfg.Host)
+			lines = append(lines, model.SyncLine{Type: model.SyncLineTypeError, Value: "The current token is invalid"})
+		}
+	}
+
+	events <- model.SyncEvent{
+		Status: model.SyncStatusStarted,
+		Lines:  lines,
+		Login: &model.SyncInput{
+			Content:     fmt.Sprintf("Please provide a personal access token for %s with scope 'read_api'...", cfg.Host),
+			Placeholder: "Access token",
+			Type:        model.SyncLoginTypeText,
+			Input:       contChannel,
+		},
+	}
+
+	value := <-contChannel
+
+	events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+
+	if token := value.Text; token != "" {
+		if ok := m.checkToken(cfg, token); !ok {
+			return "", errors.New("The provided token is invalid")
+		}
+
+		m.cache.PutSecret(secretKeyAccessToken, cfg.Host, token)
+
+		return token, nil
+	}
+
+	return "", errAbort
+}
+
+func (m *Manager) getSnippetsFromAPI(cfg InstanceConfig, source, token string, cache *sourceStore) *sourceStore {
+	etag := ""
+	if cache != nil {
+		log.Debug().Msgf("cached previous store available for %s (%s)", cfg.Host, source)
+		etag = cache.ETag
+	}
+
+	resp := m.getSnippets(cfg, source, etag, token)
+	if !resp.hasUpdates {
+		return cache
+	}
+
+	var snippets []rawSnippet
+	for _, snippet := range *resp.snippetsResponse {
+		files := snippet.snippetFiles()
+		for _, file := range files {
+			id := fmt.Sprintf("%s-%d-%s", cfg.Host, snippet.ID, file.path)
+
+			fileETag := ""
+			var prevRawSnippet *rawSnippet
+			if cache != nil {
+				if prevRawSnippet = cache.getRawSnippet(id); prevRawSnippet != nil {
+					fileETag = prevRawSnippet.ETag
+					log.Trace().Msgf("Previous etag for %s: %s", id, fileETag)
+				}
+			}
+
+			raw := rawSnippet{
+				ID:             id,
+				Filename:       file.path,
+				Visibility:     snippet.Visibility,
+				Title:          snippet.Title,
+				Description:    snippet.Description,
+				WebURL:         snippet.WebURL,
+				FilesInSnippet: len(files),
+				CreatedAt:      snippet.CreatedAt,
+				UpdatedAt:      snippet.UpdatedAt,
+			}
+
+			if fileResp := m.getRawSnippet(file.rawURL, fileETag, token); fileResp.hasUpdates {
+				raw.Content = *fileResp.rawContent
+				raw.ETag = fileResp.etag
+			} else if prevRawSnippet != nil {
+				raw.Content = prevRawSnippet.Content
+				raw.ETag = prevRawSnippet.ETag
+			}
+
+			snippets = append(snippets, raw)
+		}
+	}
+
+	return &sourceStore{Host: cfg.Host, Source: source, ETag: resp.etag, RawSnippets: snippets}
+}
+
+func compileSuffixRegex(suffixes []string) []*regexp.Regexp {
+	result := make([]*regexp.Regexp, len(suffixes))
+	for i, s := range suffixes {
+		result[i] = regexp.MustCompile(s)
+	}
+	return result
+}
+
+func checkSuffix(filename string, regexes []*regexp.Regexp) bool {
+	if len(regexes) == 0 {
+		return true
+	}
+
+	suffix := filepath.Ext(filename)
+	for _, r := range regexes {
+		if r.MatchString(suffix) {
+			return true
+		}
+	}
+	return false
+}
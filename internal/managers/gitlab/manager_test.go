@@ -0,0 +1,409 @@
+package gitlab
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+	mocks "github.com/lemoony/snipkit/mocks/cache"
+)
+
+const (
+	testHost                    = "gitlab.test"
+	testToken                   = "test_token"
+	testProject                 = "group/project"
+	testDataSnippetsPath        = "testdata/gitlab_snippets_response.json"
+	testDataProjectSnippetsPath = "testdata/gitlab_project_snippets_response.json"
+	testAPIURL                  = "https://" + testHost + "/api/v4"
+)
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{
+		Enabled:   true,
+		Instances: []InstanceConfig{{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodNone}},
+	}
+
+	system := testutil.NewTestSystem()
+	manager, err := NewManager(WithSystem(system), WithConfig(config), WithCache(cache.New(system)))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "GitLab enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "GitLab number of instances", info[1].Key)
+	assert.Equal(t, "1", info[1].Value)
+
+	assert.Equal(t, "GitLab total number of snippets", info[2].Key)
+	assert.Equal(t, "0", info[2].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name        string
+		includeTags []string
+		suffixRegex []string
+		expectedLen int
+	}{
+		{name: "no filter", expectedLen: 3},
+		{name: "tag filter", includeTags: []string{"foo"}, expectedLen: 1},
+		{name: "suffix filter", suffixRegex: []string{".sh"}, expectedLen: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheMock := mocks.Cache{}
+			cacheMock.On("GetData", storeKey).Return(expectedStoreForTestData().serialize(), true)
+
+			manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Instances: []InstanceConfig{
+				{Enabled: true, Host: testHost, IncludeTags: tt.includeTags, SuffixRegex: tt.suffixRegex},
+			}}}
+
+			assert.Len(t, manager.GetSnippets(), tt.expectedLen)
+		})
+	}
+}
+
+// Scenario: Auth method is none and only project snippets are configured.
+// Expected: No token is requested and the project snippets are stored in the cache.
+func Test_Sync_noAuth(t *testing.T) {
+	defer gock.Off()
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(nil, false)
+	cacheMock.On("PutData", storeKey, mock.Anything).Return()
+
+	mockProjectSnippets(t, "")
+	mockRawFiles()
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Instances: []InstanceConfig{
+		{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodNone, Projects: []string{testProject}},
+	}}}
+
+	runSync(t, manager, "")
+
+	expected := expectedStoreForTestData()
+	expected.Sources = expected.Sources[1:]
+	cacheMock.AssertCalled(t, "PutData", storeKey, expected.serialize())
+}
+
+// Scenario: Auth method is PAT and no token was provided previously.
+// Expected: UI should prompt for a token which is stored as secret and used for all requests.
+func Test_Sync_patAuth(t *testing.T) {
+	defer gock.Off()
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetSecret", secretKeyAccessToken, testHost).Return("", false)
+	cacheMock.On("PutSecret", secretKeyAccessToken, testHost, testToken).Return()
+	cacheMock.On("GetData", storeKey).Return(nil, false)
+	cacheMock.On("PutData", storeKey, mock.Anything).Return()
+
+	gock.New(testAPIURL).MatchHeader(headerPrivateToken, testToken).Get("/user").Reply(http.StatusOK).JSON(`{"id": 1}`)
+	gock.New(testAPIURL).
+		MatchHeader(headerPrivateToken, testToken).
+		Get("/snippets").
+		Reply(http.StatusOK).
+		SetHeader("etag", `W/"personal_etag"`).
+		JSON(readTestdata(t, testDataSnippetsPath))
+	mockProjectSnippets(t, testToken)
+	mockRawFiles()
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Instances: []InstanceConfig{
+		{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodPAT, PersonalSnippets: true, Projects: []string{testProject}},
+	}}}
+
+	runSync(t, manager, testToken)
+
+	cacheMock.AssertCalled(t, "PutSecret", secretKeyAccessToken, testHost, testToken)
+	cacheMock.AssertCalled(t, "PutData", storeKey, expectedStoreForTestData().serialize())
+}
+
+// Scenario: Stored token is invalid and user aborts the prompt for a new one.
+// Expected: Secret is deleted and the cache is not updated.
+func Test_Sync_patAuth_expired_abort(t *testing.T) {
+	defer gock.Off()
+
+	const expiredToken = "expired_token"
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(nil, false)
+	cacheMock.On("GetSecret", secretKeyAccessToken, testHost).Return(expiredToken, true)
+	cacheMock.On("DeleteSecret", secretKeyAccessToken, testHost).Return()
+
+	gock.New(testAPIURL).MatchHeader(headerPrivateToken, expiredToken).Get("/user").Reply(http.StatusUnauthorized)
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Instances: []InstanceConfig{
+		{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodPAT, PersonalSnippets: true},
+	}}}
+
+	events := make(model.SyncEventChannel)
+	go func() {
+		defer close(events)
+		manager.Sync(events)
+	}()
+
+	didReceiveAbort := false
+	for event := range events {
+		if login := event.Login; login != nil {
+			login.Input <- model.SyncInputResult{Abort: true}
+		}
+		if event.Status == model.SyncStatusAborted {
+			didReceiveAbort = true
+		}
+	}
+
+	assert.True(t, didReceiveAbort)
+	cacheMock.AssertCalled(t, "DeleteSecret", secretKeyAccessToken, testHost)
+	cacheMock.AssertNotCalled(t, "PutData", storeKey, mock.Anything)
+}
+
+// Scenario: Sync is triggered and the cache already contains entries. GitLab reports no changes (status 304).
+// Expected: The same data is put into the store as it was retrieved previously.
+func Test_Sync_ifNoneMatch(t *testing.T) {
+	defer gock.Off()
+
+	cachedStore := expectedStoreForTestData()
+	cachedStore.Sources = cachedStore.Sources[1:]
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(cachedStore.serialize(), true)
+	cacheMock.On("PutData", storeKey, mock.Anything).Return()
+
+	gock.New(testAPIURL).
+		MatchHeader("If-None-Match", cachedStore.Sources[0].ETag).
+		Get("/projects/group/project/snippets").
+		Reply(http.StatusNotModified)
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Instances: []InstanceConfig{
+		{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodNone, Projects: []string{testProject}},
+	}}}
+
+	runSync(t, manager, "")
+
+	cacheMock.AssertCalled(t, "PutData", storeKey, cachedStore.serialize())
+	assert.True(t, gock.IsDone())
+}
+
+// Scenario: The snippets of a project span two pages. Between two syncs, only a snippet on the second page changes, so
+// GitLab would report the first page as unchanged (status 304).
+// Expected: No ETag is stored for the source and the second sync retrieves all pages including the changed snippet.
+func Test_Sync_multiplePages_changeOnSecondPage(t *testing.T) {
+	defer gock.Off()
+
+	mockPages := func(secondTitle string) {
+		gock.New(testAPIURL).
+			Get("/projects/group/project/snippets").
+			MatchHeader("If-None-Match", "first_page").
+			Reply(http.StatusNotModified)
+		gock.New(testAPIURL).
+			Get("/projects/group/project/snippets").
+			MatchParam("per_page", "100").
+			Reply(http.StatusOK).
+			SetHeader("etag", "first_page").
+			SetHeader(headerNextPage, "2").
+			JSON(`[{"id": 1, "title": "First", "file_name": "first.sh", "raw_url": "https://gitlab.test/raw/1"}]`)
+		gock.New(testAPIURL).
+			Get("/projects/group/project/snippets").
+			MatchParam("page", "2").
+			Reply(http.StatusOK).
+			JSON(`[{"id": 2, "title": "` + secondTitle + `", "file_name": "second.sh", "raw_url": "https://gitlab.test/raw/2"}]`)
+		gock.New("https://gitlab.test/raw/1").Reply(http.StatusOK).BodyString("echo first")
+		gock.New("https://gitlab.test/raw/2").Reply(http.StatusOK).BodyString("echo second")
+	}
+
+	config := Config{Enabled: true, Instances: []InstanceConfig{
+		{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodNone, Projects: []string{testProject}},
+	}}
+
+	var stored []byte
+	firstCache := mocks.Cache{}
+	firstCache.On("GetData", storeKey).Return(nil, false)
+	firstCache.On("PutData", storeKey, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).([]byte)
+	}).Return()
+
+	mockPages("Second")
+	runSync(t, &Manager{cache: &firstCache, config: config}, "")
+
+	gock.Off()
+
+	secondCache := mocks.Cache{}
+	secondCache.On("GetData", storeKey).Return(stored, true)
+	secondCache.On("PutData", storeKey, mock.Anything).Return()
+
+	mockPages("Second updated")
+	runSync(t, &Manager{cache: &secondCache, config: config}, "")
+
+	var result store
+	result.deserialize(secondCache.Calls[len(secondCache.Calls)-1].Arguments.Get(1).([]byte))
+	assert.Len(t, result.Sources, 1)
+	assert.Empty(t, result.Sources[0].ETag)
+	assert.Len(t, result.Sources[0].RawSnippets, 2)
+	assert.Equal(t, "Second updated", result.Sources[0].RawSnippets[1].Title)
+}
+
+// Scenario: The snippet list has changed but a single file has not (status 304 for the raw file).
+// Expected: The content of the unchanged file is taken from the previous store.
+func Test_Sync_ifNoneMatch_forSingleFile(t *testing.T) {
+	defer gock.Off()
+
+	cachedStore := expectedStoreForTestData()
+	cachedStore.Sources = cachedStore.Sources[1:]
+
+	cacheMock := mocks.Cache{}
+	cacheMock.On("GetData", storeKey).Return(cachedStore.serialize(), true)
+	cacheMock.On("PutData", storeKey, mock.Anything).Return()
+
+	gock.New(testAPIURL).
+		MatchHeader("If-None-Match", cachedStore.Sources[0].ETag).
+		Get("/projects/group/project/snippets").
+		Reply(http.StatusOK).
+		SetHeader("etag", "project_etag_updated").
+		JSON(readTestdata(t, testDataProjectSnippetsPath))
+	gock.New("https://gitlab.test/group/project/-/snippets/7/raw/main/deploy.sh").
+		MatchHeader("If-None-Match", "deploy_etag").
+		Reply(http.StatusNotModified)
+	gock.New("https://gitlab.test/group/project/-/snippets/7/raw/main/values.yaml").
+		MatchHeader("If-None-Match", "values_etag").
+		Reply(http.StatusOK).
+		SetHeader("etag", "values_etag_updated").
+		BodyString("updated: true")
+
+	manager := &Manager{cache: &cacheMock, config: Config{Enabled: true, Instances: []InstanceConfig{
+		{Enabled: true, Host: testHost, AuthenticationMethod: AuthMethodNone, Projects: []string{testProject}},
+	}}}
+
+	runSync(t, manager, "")
+
+	expected := *cachedStore
+	expected.Sources[0].ETag = "project_etag_updated"
+	expected.Sources[0].RawSnippets[1].ETag = "values_etag_updated"
+	expected.Sources[0].RawSnippets[1].Content = []byte("updated: true")
+	cacheMock.AssertCalled(t, "PutData", storeKey, expected.serialize())
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
+
+func runSync(t *testing.T, manager *Manager, token string) {
+	t.Helper()
+
+	events := make(model.SyncEventChannel)
+	go func() {
+		defer close(events)
+		manager.Sync(events)
+	}()
+
+	for event := range events {
+		t.Logf("Received event: %v\n", event)
+		if login := event.Login; login != nil {
+			login.Input <- model.SyncInputResult{Text: token}
+		}
+		assert.NotEqual(t, model.SyncStatusAborted, event.Status)
+	}
+}
+
+func mockProjectSnippets(t *testing.T, token string) {
+	t.Helper()
+	req := gock.New(testAPIURL)
+	if token != "" {
+		req = req.MatchHeader(headerPrivateToken, token)
+	}
+	req.Get("/projects/group/project/snippets").
+		MatchParam("per_page", "100").
+		Reply(http.StatusOK).
+		SetHeader("etag", "project_etag").
+		JSON(readTestdata(t, testDataProjectSnippetsPath))
+}
+
+func mockRawFiles() {
+	gock.New("https://gitlab.test/-/snippets/42/raw/main/echo.sh").Reply(http.StatusOK).
+		SetHeader("etag", "echo_etag").BodyString("echo foo")
+	gock.New("https://gitlab.test/group/project/-/snippets/7/raw/main/deploy.sh").Reply(http.StatusOK).
+		SetHeader("etag", "deploy_etag").BodyString("./deploy.sh")
+	gock.New("https://gitlab.test/group/project/-/snippets/7/raw/main/values.yaml").Reply(http.StatusOK).
+		SetHeader("etag", "values_etag").BodyString("foo: bar")
+}
+
+func readTestdata(t *testing.T, path string) string {
+	t.Helper()
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return string(contents)
+}
+
+func expectedStoreForTestData() *store {
+	return &store{
+		Version: storeVersion,
+		Sources: []sourceStore{
+			{
+				Host:   testHost,
+				Source: sourcePersonal,
+				ETag:   "personal_etag",
+				RawSnippets: []rawSnippet{
+					{
+						ID:             "gitlab.test-42-echo.sh",
+						Filename:       "echo.sh",
+						Content:        []byte("echo foo"),
+						ETag:           "echo_etag",
+						Visibility:     "private",
+						Title:          "Echo Something #foo",
+						Description:    "Prints something #bar",
+						WebURL:         "https://gitlab.test/-/snippets/42",
+						FilesInSnippet: 1,
+						CreatedAt:      time.Date(2023, 2, 28, 9, 0, 0, 0, time.UTC),
+						UpdatedAt:      time.Date(2023, 3, 1, 10, 12, 0, 0, time.UTC),
+					},
+				},
+			},
+			{
+				Host:   testHost,
+				Source: sourceProject + testProject,
+				ETag:   "project_etag",
+				RawSnippets: []rawSnippet{
+					{
+						ID:             "gitlab.test-7-deploy.sh",
+						Filename:       "deploy.sh",
+						Content:        []byte("./deploy.sh"),
+						ETag:           "deploy_etag",
+						Visibility:     "internal",
+						Title:          "Deployment helpers",
+						WebURL:         "https://gitlab.test/group/project/-/snippets/7",
+						FilesInSnippet: 2,
+						CreatedAt:      time.Date(2023, 3, 1, 9, 0, 0, 0, time.UTC),
+						UpdatedAt:      time.Date(2023, 3, 2, 10, 12, 0, 0, time.UTC),
+					},
+					{
+						ID:             "gitlab.test-7-values.yaml",
+						Filename:       "values.yaml",
+						Content:        []byte("foo: bar"),
+						ETag:           "values_etag",
+						Visibility:     "internal",
+						Title:          "Deployment helpers",
+						WebURL:         "https://gitlab.test/group/project/-/snippets/7",
+						FilesInSnippet: 2,
+						CreatedAt:      time.Date(2023, 3, 1, 9, 0, 0, 0, time.UTC),
+						UpdatedAt:      time.Date(2023, 3, 2, 10, 12, 0, 0, time.UTC),
+					},
+				},
+			},
+		},
+	}
+}
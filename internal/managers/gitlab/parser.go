@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/lemoony/snipkit/internal/managers/fslibrary"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/titleheader"
+)
+
+var tagRegex = regexp.MustCompile(`#\S+`)
+
+func parseSnippet(raw rawSnippet, cfg InstanceConfig) model.Snippet {
+	result := snippetImpl{
+		id:       idutil.FormatSnippetID(raw.ID, idPrefix),
+		tags:     parseTags(fmt.Sprintf("%s %s", raw.Title, raw.Description)),
+		title:    parseTitle(raw, cfg),
+		content:  formatContent(string(raw.Content), cfg.HideTitleInPreview),
+		language: mapLanguage(raw.Filename),
+		metadata: model.SnippetMetadata{
+			Description: pruneTags(raw.Description),
+			Manager:     Key,
+			Source:      raw.WebURL,
+			Created:     raw.CreatedAt,
+			Modified:    raw.UpdatedAt,
+			ReadOnly:    true,
+		},
+	}
+	return &result
+}
+
+func parseTitle(raw rawSnippet, cfg InstanceConfig) string {
+	if cfg.TitleHeaderEnabled {
+		if title, ok := titleheader.ParseTitleFromHeader(string(raw.Content)); ok {
+			return title
+		}
+	}
+
+	title := raw.Title
+	if cfg.RemoveTagsFromTitle {
+		title = pruneTags(title)
+	}
+
+	switch cfg.NameMode {
+	case SnippetNameModeTitle:
+		return title
+	case SnippetNameModeFilename:
+		return raw.Filename
+	case SnippetNameModeCombine:
+		return fmt.Sprintf("%s - %s", title, raw.Filename)
+	case SnippetNameModeCombinePreferTitle:
+		if raw.FilesInSnippet == 1 {
+			return title
+		}
+	}
+	return fmt.Sprintf("%s - %s", title, raw.Filename)
+}
+
+func parseTags(text string) []string {
+	tags := tagRegex.FindAllString(text, -1)
+	for i := range tags {
+		tags[i] = tags[i][1:]
+	}
+	if len(tags) == 0 {
+		return []string{}
+	}
+	return tags
+}
+
+func pruneTags(text string) string {
+	return strings.Join(strings.Fields(tagRegex.ReplaceAllString(text, "")), " ")
+}
+
+func formatContent(text string, hideTitleHeader bool) string {
+	if hideTitleHeader {
+		return titleheader.PruneTitleHeader(text)
+	}
+	return text
+}
+
+func mapLanguage(filename string) model.Language {
+	if lang := fslibrary.LanguageForSuffix(filepath.Ext(filename)); lang != model.LanguageUnknown {
+		return lang
+	}
+	return model.LanguageText
+}
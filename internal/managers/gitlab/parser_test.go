@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+func Test_parseTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      rawSnippet
+		cfg      InstanceConfig
+		expected string
+	}{
+		{
+			name:     "title",
+			raw:      rawSnippet{Title: "Title #tag", Filename: "foo.sh"},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeTitle},
+			expected: "Title #tag",
+		},
+		{
+			name:     "title without tags",
+			raw:      rawSnippet{Title: "Title #tag with tag", Filename: "foo.sh"},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeTitle, RemoveTagsFromTitle: true},
+			expected: "Title with tag",
+		},
+		{
+			name:     "filename",
+			raw:      rawSnippet{Title: "Title", Filename: "foo.sh"},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeFilename},
+			expected: "foo.sh",
+		},
+		{
+			name:     "combine",
+			raw:      rawSnippet{Title: "Title", Filename: "foo.sh", FilesInSnippet: 1},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeCombine},
+			expected: "Title - foo.sh",
+		},
+		{
+			name:     "combine prefer title - single file",
+			raw:      rawSnippet{Title: "Title", Filename: "foo.sh", FilesInSnippet: 1},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeCombinePreferTitle},
+			expected: "Title",
+		},
+		{
+			name:     "combine prefer title - multiple files",
+			raw:      rawSnippet{Title: "Title", Filename: "foo.sh", FilesInSnippet: 2},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeCombinePreferTitle},
+			expected: "Title - foo.sh",
+		},
+		{
+			name:     "title header",
+			raw:      rawSnippet{Title: "Title", Filename: "foo.sh", Content: []byte("#\n# Header title\n#\necho foo")},
+			cfg:      InstanceConfig{NameMode: SnippetNameModeTitle, TitleHeaderEnabled: true},
+			expected: "Header title",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseTitle(tt.raw, tt.cfg))
+		})
+	}
+}
+
+func Test_parseSnippet(t *testing.T) {
+	raw := rawSnippet{
+		ID:             "gitlab.test-42-echo.sh",
+		Title:          "Echo #foo",
+		Description:    "Prints something #bar",
+		Filename:       "echo.sh",
+		FilesInSnippet: 1,
+		Content:        []byte("#\n# Echo\n#\necho foo"),
+	}
+
+	snippet := parseSnippet(raw, InstanceConfig{NameMode: SnippetNameModeTitle, RemoveTagsFromTitle: true, HideTitleInPreview: true})
+	assert.Equal(t, "Echo", snippet.GetTitle())
+	assert.Equal(t, []string{"foo", "bar"}, snippet.GetTags())
+	assert.Equal(t, "echo foo", snippet.GetContent())
+	assert.Equal(t, model.LanguageBash, snippet.GetLanguage())
+}
+
+func Test_mapLanguage(t *testing.T) {
+	assert.Equal(t, model.LanguageBash, mapLanguage("foo.sh"))
+	assert.Equal(t, model.LanguageYAML, mapLanguage("foo.yaml"))
+	assert.Equal(t, model.LanguageText, mapLanguage("foo"))
+}
+
+func Test_toStrongETag(t *testing.T) {
+	assert.Equal(t, "abc", toStrongETag(`W/"abc"`))
+	assert.Equal(t, "abc", toStrongETag(`"abc"`))
+	assert.Equal(t, "", toStrongETag(""))
+}
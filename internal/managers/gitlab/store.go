@@ -0,0 +1,85 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/cache"
+)
+
+const (
+	storeKey     = cache.DataKey("gitlab_cache")
+	storeVersion = "1.0"
+)
+
+type store struct {
+	Version string        `json:"version"`
+	Sources []sourceStore `json:"sources"`
+}
+
+type sourceStore struct {
+	Host        string       `json:"host"`
+	Source      string       `json:"source"`
+	ETag        string       `json:"etag"`
+	RawSnippets []rawSnippet `json:"rawSnippets"`
+}
+
+type rawSnippet struct {
+	ID             string    `json:"id"`
+	Filename       string    `json:"filename"`
+	Content        []byte    `json:"content"`
+	ETag           string    `json:"etag"`
+	Visibility     string    `json:"visibility"`
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	WebURL         string    `json:"webURL"`
+	FilesInSnippet int       `json:"filesInSnippet"`
+	CreatedAt      time.Time `json:"createdAt,omitzero"`
+	UpdatedAt      time.Time `json:"updatedAt,omitzero"`
+}
+
+func (m *Manager) getStoreFromCache() *store {
+	result := &store{}
+	if raw, ok := m.cache.GetData(storeKey); ok {
+		result.deserialize(raw)
+	}
+	return result
+}
+
+func (m *Manager) storeInCache(s *store) {
+	m.cache.PutData(storeKey, s.serialize())
+}
+
+func (c *store) serialize() []byte {
+	if bytes, err := json.Marshal(c); err != nil {
+		panic(err)
+	} else {
+		return bytes
+	}
+}
+
+func (c *store) deserialize(bytes []byte) {
+	if err := json.Unmarshal(bytes, c); err != nil {
+		log.Warn().Err(err).Msg("store invalid")
+	}
+}
+
+func (c *store) getSource(host, source string) *sourceStore {
+	for i := range c.Sources {
+		if c.Sources[i].Host == host && c.Sources[i].Source == source {
+			return &c.Sources[i]
+		}
+	}
+	return nil
+}
+
+func (s *sourceStore) getRawSnippet(id string) *rawSnippet {
+	for i := range s.RawSnippets {
+		if s.RawSnippets[i].ID == id {
+			return &s.RawSnippets[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package gitrepo
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+var dirNameRegex = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+type Config struct {
+	Enabled      bool               `yaml:"enabled" head_comment:"If set to false, the git repositories will not be provided to you."`
+	Repositories []RepositoryConfig `yaml:"repositories" head_comment:"You can define multiple independent git repositories. They are cloned or updated upon 'snipkit sync'."`
+}
+
+type RepositoryConfig struct {
+	Enabled            bool     `yaml:"enabled" head_comment:"If set to false, this repository is ignored."`
+	URL                string   `yaml:"url" head_comment:"URL of the git repository (any URL supported by your git installation)."`
+	Branch             string   `yaml:"branch" head_comment:"Branch to check out. If empty, the default branch of the remote repository is used."`
+	Directory          string   `yaml:"directory" head_comment:"Subdirectory within the repository which holds the snippet files. If empty, the whole repository is used."`
+	SuffixRegex        []string `yaml:"suffixRegex" head_comment:"Only files with endings which match one of the listed suffixes will be considered."`
+	HideTitleInPreview bool     `yaml:"hideTitleInPreview" head_comment:"If set to true, the title comment will not be shown in the preview window."`
+}
+
+func AutoDiscoveryConfig(system *system.System) *Config {
+	return &Config{
+		Enabled: false,
+		Repositories: []RepositoryConfig{
+			{
+				Enabled:            false,
+				URL:                "https://github.com/<yourUser>/<yourRepository>.git",
+				Branch:             "main",
+				Directory:          "",
+				SuffixRegex:        []string{".sh"},
+				HideTitleInPreview: true,
+			},
+		},
+	}
+}
+
+// checkoutDir returns the local directory the repository is cloned into.
+func (r RepositoryConfig) checkoutDir(system *system.System) string {
+	return filepath.Join(system.UserDataHome(), dataDirName, checkoutDirName(r))
+}
+
+// snippetsDir returns the local directory which holds the snippet files of the repository.
+func (r RepositoryConfig) snippetsDir(system *system.System) string {
+	return filepath.Join(r.checkoutDir(system), filepath.Clean("/"+r.Directory))
+}
+
+func checkoutDirName(r RepositoryConfig) string {
+	name := dirNameRegex.ReplaceAllString(r.URL, "_")
+	if r.Branch != "" {
+		name += "@" + dirNameRegex.ReplaceAllString(r.Branch, "_")
+	}
+	return name
+}
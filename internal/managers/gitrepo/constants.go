@@ -0,0 +1,9 @@
+package gitrepo
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	dataDirName                 = "snipkit/git"
+	gitDirName                  = ".git"
+	idPrefix    idutil.IDPrefix = "git"
+)
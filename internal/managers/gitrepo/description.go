@@ -0,0 +1,14 @@
+package gitrepo
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("gitRepository")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "Git Repository",
+		Description: "Use snippets from one or more git repositories which are cloned and updated locally",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
@@ -0,0 +1,81 @@
+package gitrepo
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+)
+
+const gitExecutable = "git"
+
+var errGit = errors.New("git command failed")
+
+// gitRunner executes a git command in the given working directory and returns its combined output.
+type gitRunner func(dir string, args ...string) (string, error)
+
+func runGit(dir string, args ...string) (string, error) {
+	//nolint:gosec // since it would report G204 complaining about using a variable as input for exec.Command
+	cmd := exec.Command(gitExecutable, args...)
+	cmd.Dir = dir
+	// never prompt for credentials since there is no terminal attached to the sync screen
+	cmd.Env = append(cmd.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	log.Trace().Str("dir", dir).Strs("args", args).Msg("Running git command")
+
+	if err := cmd.Run(); err != nil {
+		return output.String(), errors.Wrapf(errGit, "git %s: %s", strings.Join(args, " "), lastLine(output.String(), err))
+	}
+	return output.String(), nil
+}
+
+func (m *Manager) clone(repo RepositoryConfig, dir string) error {
+	args := []string{"clone", "--single-branch"}
+	if repo.Branch != "" {
+		args = append(args, "--branch", repo.Branch)
+	}
+	args = append(args, "--", repo.URL, dir)
+	_, err := m.git("", args...)
+	return err
+}
+
+// pull fast-forwards the local checkout and returns true if the revision has changed.
+func (m *Manager) pull(repo RepositoryConfig, dir string) (bool, error) {
+	before, err := m.revision(dir)
+	if err != nil {
+		return false, err
+	}
+
+	args := []string{"pull", "--ff-only"}
+	if repo.Branch != "" {
+		args = append(args, "origin", repo.Branch)
+	}
+	if _, err = m.git(dir, args...); err != nil {
+		return false, err
+	}
+
+	after, err := m.revision(dir)
+	if err != nil {
+		return false, err
+	}
+	return before != after, nil
+}
+
+func (m *Manager) revision(dir string) (string, error) {
+	out, err := m.git(dir, "rev-parse", "HEAD")
+	return strings.TrimSpace(out), err
+}
+
+func lastLine(output string, err error) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if line := strings.TrimSpace(lines[len(lines)-1]); line != "" {
+		return line
+	}
+	return err.Error()
+}
@@ -0,0 +1,254 @@
+package gitrepo
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/managers/fslibrary"
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/titleheader"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+	git    gitRunner
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(p *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(manager *Manager)
+
+func (f optionFunc) apply(manager *Manager) {
+	f(manager)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(p *Manager) {
+		p.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(p *Manager) {
+		p.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{git: runGit}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Git repository enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	for _, repo := range m.enabledRepositories() {
+		dir := repo.checkoutDir(m.system)
+		if m.isCloned(dir) {
+			lines = append(lines, model.InfoLine{Key: fmt.Sprintf("Git repository %s", repo.URL), Value: dir})
+		} else {
+			lines = append(lines, model.InfoLine{
+				IsError: true,
+				Key:     fmt.Sprintf("Git repository %s", repo.URL),
+				Value:   "Not cloned yet - run 'snipkit sync'",
+			})
+		}
+	}
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Git repository total number of snippets",
+		Value:   fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+	for _, repo := range m.enabledRepositories() {
+		if !m.isCloned(repo.checkoutDir(m.system)) {
+			log.Info().Msgf("Git repository %s has not been cloned yet", repo.URL)
+			continue
+		}
+
+		dir := repo.snippetsDir(m.system)
+		if !m.system.DirExists(dir) {
+			log.Warn().Msgf("Directory %s does not exist in git repository %s", repo.Directory, repo.URL)
+			continue
+		}
+
+		result = append(result, m.snippetsFromDir(repo, dir, compileSuffixRegex(repo.SuffixRegex))...)
+	}
+	return result
+}
+
+func (m *Manager) Sync(events model.SyncEventChannel) {
+	var lines []model.SyncLine
+	log.Trace().Msg("git repository sync started")
+
+	events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+
+	failed := false
+	for _, repo := range m.enabledRepositories() {
+		dir := repo.checkoutDir(m.system)
+
+		var line model.SyncLine
+		if m.isCloned(dir) {
+			lines = append(lines, model.SyncLine{Type: model.SyncLineTypeInfo, Value: fmt.Sprintf("Updating %s", repo.URL)})
+			events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+			line = m.syncPull(repo, dir)
+		} else {
+			lines = append(lines, model.SyncLine{Type: model.SyncLineTypeInfo, Value: fmt.Sprintf("Cloning %s", repo.URL)})
+			events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+			line = m.syncClone(repo, dir)
+		}
+
+		failed = failed || line.Type == model.SyncLineTypeError
+		lines = append(lines, line)
+		events <- model.SyncEvent{Status: model.SyncStatusStarted, Lines: lines}
+	}
+
+	if failed {
+		events <- model.SyncEvent{Status: model.SyncStatusAborted, Lines: lines}
+	} else {
+		events <- model.SyncEvent{Status: model.SyncStatusFinished, Lines: lines}
+	}
+
+	log.Trace().Msg("git repository sync finished")
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+func (m *Manager) syncClone(repo RepositoryConfig, dir string) model.SyncLine {
+	if err := m.clone(repo, dir); err != nil {
+		log.Error().Err(err).Str("url", repo.URL).Msg("Failed to clone git repository")
+		return model.SyncLine{Type: model.SyncLineTypeError, Value: err.Error()}
+	}
+	return model.SyncLine{Type: model.SyncLineTypeSuccess, Value: fmt.Sprintf("Cloned %s", repo.URL)}
+}
+
+func (m *Manager) syncPull(repo RepositoryConfig, dir string) model.SyncLine {
+	changed, err := m.pull(repo, dir)
+	switch {
+	case err != nil:
+		log.Error().Err(err).Str("url", repo.URL).Msg("Failed to update git repository")
+		return model.SyncLine{Type: model.SyncLineTypeError, Value: err.Error()}
+	case changed:
+		return model.SyncLine{Type: model.SyncLineTypeSuccess, Value: fmt.Sprintf("Updated %s", repo.URL)}
+	default:
+		return model.SyncLine{Type: model.SyncLineTypeSuccess, Value: fmt.Sprintf("%s is up to date", repo.URL)}
+	}
+}
+
+func (m *Manager) snippetsFromDir(repo RepositoryConfig, dir string, suffixRegex []*regexp.Regexp) []model.Snippet {
+	var result []model.Snippet
+
+	entries, err := afero.ReadDir(m.system.Fs, dir)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			if entry.Name() != gitDirName {
+				result = append(result, m.snippetsFromDir(repo, filepath.Join(dir, entry.Name()), suffixRegex)...)
+			}
+			continue
+		}
+
+		fileName := entry.Name()
+		filePath := filepath.Join(dir, fileName)
+
+		if !checkSuffix(fileName, suffixRegex) {
+			continue
+		}
+
+		contents := string(m.system.ReadFile(filePath))
+		title := fileName
+		if t, ok := titleheader.ParseTitleFromHeader(contents); ok {
+			title = t
+		}
+		if repo.HideTitleInPreview {
+			contents = titleheader.PruneTitleHeader(contents)
+		}
+
+		result = append(result, &snippetImpl{
+			id:       idutil.FormatSnippetID(filePath, idPrefix),
+			title:    title,
+			content:  contents,
+			tags:     []string{},
+			language: fslibrary.LanguageForSuffix(filepath.Ext(fileName)),
+			metadata: model.SnippetMetadata{Manager: Key, Source: filePath, Modified: entry.ModTime(), ReadOnly: true},
+		})
+	}
+
+	return result
+}
+
+func (m *Manager) enabledRepositories() []RepositoryConfig {
+	var result []RepositoryConfig
+	if !m.config.Enabled {
+		return result
+	}
+	for _, repo := range m.config.Repositories {
+		if repo.Enabled {
+			result = append(result, repo)
+		}
+	}
+	return result
+}
+
+func (m *Manager) isCloned(dir string) bool {
+	return m.system.DirExists(filepath.Join(dir, gitDirName))
+}
+
+func compileSuffixRegex(suffixes []string) []*regexp.Regexp {
+	result := make([]*regexp.Regexp, len(suffixes))
+	for i, s := range suffixes {
+		result[i] = regexp.MustCompile(s)
+	}
+	return result
+}
+
+func checkSuffix(filename string, regexes []*regexp.Regexp) bool {
+	if len(regexes) == 0 {
+		return true
+	}
+
+	suffix := filepath.Ext(filename)
+	for _, r := range regexes {
+		if r.MatchString(suffix) {
+			return true
+		}
+	}
+	return false
+}
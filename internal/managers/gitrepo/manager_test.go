@@ -0,0 +1,192 @@
+package gitrepo
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+const (
+	testSnippetDir     = "snippets"
+	testSnippetContent = "#\n# Echo something\n#\necho \"foo\""
+)
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{
+		Enabled:      true,
+		Repositories: []RepositoryConfig{{Enabled: true, URL: "https://example.com/foo.git"}},
+	}
+
+	sys := testutil.NewTestSystem(system.WithUserDataDir(t.TempDir()))
+	manager, err := NewManager(WithSystem(sys), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "Git repository enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+	assert.False(t, info[0].IsError)
+
+	assert.Equal(t, "Git repository https://example.com/foo.git", info[1].Key)
+	assert.True(t, info[1].IsError)
+
+	assert.Equal(t, "Git repository total number of snippets", info[2].Key)
+	assert.Equal(t, "0", info[2].Value)
+	assert.False(t, info[2].IsError)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync_cloneAndPull(t *testing.T) {
+	remote, work := createTestRepository(t)
+
+	config := Config{
+		Enabled: true,
+		Repositories: []RepositoryConfig{
+			{Enabled: true, URL: remote, Branch: "main", Directory: testSnippetDir, SuffixRegex: []string{".sh"}, HideTitleInPreview: true},
+		},
+	}
+
+	sys := testutil.NewTestSystem(system.WithUserDataDir(t.TempDir()))
+	manager, err := NewManager(WithSystem(sys), WithConfig(config))
+	assert.NoError(t, err)
+
+	assert.Empty(t, manager.GetSnippets())
+
+	lines := syncAndWait(t, manager, model.SyncStatusFinished)
+	assert.Equal(t, model.SyncLine{Type: model.SyncLineTypeSuccess, Value: "Cloned " + remote}, lines[len(lines)-1])
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "Echo something", snippets[0].GetTitle())
+	assert.Equal(t, `echo "foo"`, snippets[0].GetContent())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+	assert.Equal(t, Key, snippets[0].GetMetadata().Manager)
+	assert.FileExists(t, snippets[0].GetMetadata().Source)
+	assert.True(t, snippets[0].GetMetadata().ReadOnly)
+
+	lines = syncAndWait(t, manager, model.SyncStatusFinished)
+	assert.Equal(t, model.SyncLine{Type: model.SyncLineTypeSuccess, Value: remote + " is up to date"}, lines[len(lines)-1])
+
+	commitFile(t, work, filepath.Join(testSnippetDir, "nested", "other.sh"), "echo other")
+
+	lines = syncAndWait(t, manager, model.SyncStatusFinished)
+	assert.Equal(t, model.SyncLine{Type: model.SyncLineTypeSuccess, Value: "Updated " + remote}, lines[len(lines)-1])
+	assert.Len(t, manager.GetSnippets(), 2)
+}
+
+func Test_Sync_invalidRepository(t *testing.T) {
+	requireGit(t)
+
+	config := Config{
+		Enabled:      true,
+		Repositories: []RepositoryConfig{{Enabled: true, URL: filepath.Join(t.TempDir(), "does-not-exist")}},
+	}
+
+	sys := testutil.NewTestSystem(system.WithUserDataDir(t.TempDir()))
+	manager, err := NewManager(WithSystem(sys), WithConfig(config))
+	assert.NoError(t, err)
+
+	lines := syncAndWait(t, manager, model.SyncStatusAborted)
+	assert.Equal(t, model.SyncLineTypeError, lines[len(lines)-1].Type)
+	assert.Empty(t, manager.GetSnippets())
+}
+
+func Test_checkoutDirName(t *testing.T) {
+	tests := []struct {
+		repo     RepositoryConfig
+		expected string
+	}{
+		{repo: RepositoryConfig{URL: "https://github.com/foo/bar.git"}, expected: "https_github.com_foo_bar.git"},
+		{repo: RepositoryConfig{URL: "git@github.com:foo/bar.git", Branch: "feature/x"}, expected: "git_github.com_foo_bar.git@feature_x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, checkoutDirName(tt.repo))
+		})
+	}
+}
+
+func Test_snippetsDir_staysWithinCheckout(t *testing.T) {
+	sys := testutil.NewTestSystem(system.WithUserDataDir("/data"))
+	repo := RepositoryConfig{URL: "foo", Directory: "../../etc"}
+	assert.Equal(t, "/data/snipkit/git/foo/etc", repo.snippetsDir(sys))
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
+
+func syncAndWait(t *testing.T, manager *Manager, expectedStatus model.SyncStatus) []model.SyncLine {
+	t.Helper()
+
+	events := make(model.SyncEventChannel)
+	go func() {
+		defer close(events)
+		manager.Sync(events)
+	}()
+
+	var last model.SyncEvent
+	for event := range events {
+		t.Logf("Received event: %v\n", event)
+		last = event
+	}
+
+	assert.Equal(t, expectedStatus, last.Status)
+	return last.Lines
+}
+
+// createTestRepository creates a local bare repository acting as remote plus a working copy to push commits from.
+func createTestRepository(t *testing.T) (string, string) {
+	t.Helper()
+	requireGit(t)
+
+	remote := filepath.Join(t.TempDir(), "remote.git")
+	work := filepath.Join(t.TempDir(), "work")
+
+	gitCmd(t, "", "init", "--bare", "--initial-branch=main", remote)
+	gitCmd(t, "", "clone", remote, work)
+	gitCmd(t, work, "checkout", "-b", "main")
+
+	commitFile(t, work, "README.md", "# Snippets")
+	commitFile(t, work, filepath.Join(testSnippetDir, "echo.sh"), testSnippetContent)
+
+	return remote, work
+}
+
+func commitFile(t *testing.T, work, name, content string) {
+	t.Helper()
+	path := filepath.Join(work, name)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	gitCmd(t, work, "add", name)
+	gitCmd(t, work, "-c", "user.name=snipkit", "-c", "user.email=test@snipkit.test", "commit", "-m", "add "+name)
+	gitCmd(t, work, "push", "origin", "main")
+}
+
+func gitCmd(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	if out, err := runGit(dir, args...); err != nil {
+		t.Fatalf("%s: %s", err, out)
+	}
+}
+
+func requireGit(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath(gitExecutable); err != nil {
+		t.Skip("git is not installed")
+	}
+}
@@ -9,3 +9,21 @@ type Manager interface {
 	Sync(model.SyncEventChannel)
 	SaveAssistantSnippet(snippetTitle string, filename string, contents []byte)
 }
+
+// SnippetPrioritizer can optionally be implemented by a Manager. Prioritized snippets are listed before the
+// snippets of all other managers, e.g., because they belong to the current working directory.
+type SnippetPrioritizer interface {
+	IsPrioritized(snippet model.Snippet) bool
+}
+
+// SnippetWriter can optionally be implemented by a Manager which supports creating and modifying its snippets.
+// The methods panic if the snippet with the given ID does not exist or the underlying storage cannot be written.
+type SnippetWriter interface {
+	// Writable returns false if the manager cannot write snippets with its current configuration.
+	Writable() bool
+	// CreateSnippet creates a new snippet and returns its ID.
+	CreateSnippet(draft model.SnippetDraft) string
+	UpdateSnippet(id string, draft model.SnippetDraft)
+	DeleteSnippet(id string)
+	RenameSnippet(id string, title string)
+}
@@ -11,12 +11,13 @@ type Version string
 const (
 	version1 = Version("v1")
 	version2 = Version("v2")
+	version3 = Version("v3")
 )
 
 type Config struct {
 	Enabled      bool     `yaml:"enabled" head_comment:"Set to true if you want to use pet."`
 	MassCodeHome string   `yaml:"massCodeHome" head_comment:"Path to the massCode directory containing the db files."`
-	Version      Version  `version:"Version of massCode. Allowed values: v1, v2."`
+	Version      Version  `version:"Version of massCode. Allowed values: v1, v2, v3."`
 	IncludeTags  []string `yaml:"includeTags" head_comment:"If this list is not empty, only those Snippets that match the listed Tags will be provided to you."`
 }
 
@@ -45,7 +46,10 @@ func findMassCodeHome(sys *system.System) autoDetectResult {
 		path:    defaultHome,
 	}
 
-	if v2DBFile := filepath.Join(defaultHome, v2DatabaseFile); sys.FileExists(v2DBFile) {
+	if v3DBFile := filepath.Join(defaultHome, v3DatabaseFile); sys.FileExists(v3DBFile) {
+		result.found = true
+		result.version = version3
+	} else if v2DBFile := filepath.Join(defaultHome, v2DatabaseFile); sys.FileExists(v2DBFile) {
 		result.found = true
 		result.version = version2
 	} else if v1DBFile := filepath.Join(defaultHome, v1SnippetsFile); sys.FileExists(v1DBFile) {
@@ -36,6 +36,16 @@ func Test_AutoDiscoveryConfig(t *testing.T) {
 				IncludeTags:  []string{},
 			},
 		},
+		{
+			name:        "found v3",
+			userHomeDir: testDataUserHomeV3,
+			expected: Config{
+				Enabled:      true,
+				MassCodeHome: fmt.Sprintf("%s/%s", testDataUserHomeV3, defaultMassCodeHomePath),
+				Version:      version3,
+				IncludeTags:  []string{},
+			},
+		},
 		{
 			name:        "not found",
 			userHomeDir: "testdata/userhome-not-found",
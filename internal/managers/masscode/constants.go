@@ -10,5 +10,7 @@ const (
 
 	v2DatabaseFile = "db.json"
 
+	v3DatabaseFile = "massCode.db"
+
 	idPrefix idutil.IDPrefix = "mass"
 )
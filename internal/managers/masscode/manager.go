@@ -6,6 +6,8 @@ import (
 
 	"emperror.dev/errors"
 
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/index"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/stringutil"
 	"github.com/lemoony/snipkit/internal/utils/system"
@@ -14,6 +16,7 @@ import (
 
 type Manager struct {
 	system *system.System
+	cache  cache.Cache
 	config Config
 }
 
@@ -42,6 +45,13 @@ func WithConfig(config Config) Option {
 	})
 }
 
+// WithCache sets the cache which holds the index of parsed snippets.
+func WithCache(cache cache.Cache) Option {
+	return optionFunc(func(p *Manager) {
+		p.cache = cache
+	})
+}
+
 func NewManager(options ...Option) (*Manager, error) {
 	manager := &Manager{}
 	for _, o := range options {
@@ -76,12 +86,10 @@ func (m *Manager) GetSnippets() []model.Snippet {
 	var result []model.Snippet
 	validTags := stringutil.NewStringSet(m.config.IncludeTags)
 
-	var snippets []model.Snippet
-	if m.config.Version == version1 {
-		snippets = parseDBFileV1(m.system, filepath.Join(m.system.UserHome(), defaultMassCodeHomePath))
-	} else if m.config.Version == version2 {
-		snippets = parseDBFileV2(m.system, filepath.Join(m.system.UserHome(), defaultMassCodeHomePath, v2DatabaseFile))
-	}
+	snippetIndex := index.Load(m.cache, Key, m.config)
+	snippets := snippetIndex.Snippets(m.databasePath(), m.databaseFingerprint(), m.parseDatabase)
+	snippetIndex.Save()
+
 	for _, snippet := range snippets {
 		if tagutil.HasValidTag(validTags, snippet.GetTags()) {
 			result = append(result, snippet)
@@ -90,6 +98,40 @@ func (m *Manager) GetSnippets() []model.Snippet {
 	return result
 }
 
+func (m *Manager) parseDatabase() []model.Snippet {
+	switch m.config.Version {
+	case version1:
+		return parseDBFileV1(m.system, m.databasePath())
+	case version2:
+		return parseDBFileV2(m.system, m.databasePath())
+	case version3:
+		return parseDBFileV3(m.databasePath())
+	}
+	return nil
+}
+
+func (m *Manager) databasePath() string {
+	switch m.config.Version {
+	case version2:
+		return filepath.Join(m.system.UserHome(), defaultMassCodeHomePath, v2DatabaseFile)
+	case version3:
+		return filepath.Join(m.system.UserHome(), defaultMassCodeHomePath, v3DatabaseFile)
+	}
+	return filepath.Join(m.system.UserHome(), defaultMassCodeHomePath)
+}
+
+// databaseFingerprint considers all files of the database, e.g., since SQLite may hold changes in a write-ahead log.
+func (m *Manager) databaseFingerprint() string {
+	path := m.databasePath()
+	switch m.config.Version {
+	case version1:
+		return index.FileFingerprint(m.system.Fs, filepath.Join(path, v1SnippetsFile), filepath.Join(path, v1TagsFile))
+	case version3:
+		return index.FileFingerprint(m.system.Fs, path, path+"-wal")
+	}
+	return index.FileFingerprint(m.system.Fs, path)
+}
+
 func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
 	panic(errors.New("Not implemented"))
 }
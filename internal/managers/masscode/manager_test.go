@@ -61,6 +61,10 @@ func Test_GetSnippets(t *testing.T) {
 		{name: "v2 - no tags", userHome: testDataUserHomeV2, version: version2, tags: []string{}, expectedLen: 3},
 		{name: "v2 - 1 tag", userHome: testDataUserHomeV2, version: version2, tags: []string{"snipkit"}, expectedLen: 1},
 		{name: "v2 - tag excludes all", userHome: testDataUserHomeV2, version: version2, tags: []string{"foo"}, expectedLen: 0},
+		{name: "v3 - no tags", userHome: testDataUserHomeV3, version: version3, tags: []string{}, expectedLen: 3},
+		{name: "v3 - 1 tag", userHome: testDataUserHomeV3, version: version3, tags: []string{"snipkit"}, expectedLen: 1},
+		{name: "v3 - parent folder tag", userHome: testDataUserHomeV3, version: version3, tags: []string{"DevOps"}, expectedLen: 2},
+		{name: "v3 - tag excludes all", userHome: testDataUserHomeV3, version: version3, tags: []string{"foo"}, expectedLen: 0},
 		{name: "v1 - no tags", userHome: testDataUserHomeV1, version: version1, tags: []string{}, expectedLen: 2},
 		{name: "v1 - 1 tags", userHome: testDataUserHomeV1, version: version1, tags: []string{"snipkit"}, expectedLen: 1},
 		{name: "v1 - tage excludes all", userHome: testDataUserHomeV1, version: version1, tags: []string{"foo"}, expectedLen: 0},
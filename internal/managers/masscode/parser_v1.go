@@ -13,7 +13,8 @@ func parseDBFileV1(sys *system.System, massCodePath string) []model.Snippet {
 	var result []model.Snippet
 
 	tagMap := parseRawTagMapV1(sys, filepath.Join(massCodePath, v1TagsFile))
-	snippetsMap := parseRawSnippetsV1(sys, filepath.Join(massCodePath, v1SnippetsFile))
+	snippetsPath := filepath.Join(massCodePath, v1SnippetsFile)
+	snippetsMap := parseRawSnippetsV1(sys, snippetsPath)
 
 	for _, raw := range snippetsMap {
 		result = append(result, &snippetImpl{
@@ -22,6 +23,13 @@ func parseDBFileV1(sys *system.System, massCodePath string) []model.Snippet {
 			tags:     toTagNames(raw.Tags, tagMap),
 			content:  raw.Content[0].Value,
 			language: mapLanguage(raw.Content[0].Language),
+			metadata: model.SnippetMetadata{
+				Manager:  Key,
+				Source:   snippetsPath,
+				Created:  raw.CreatedAt.Time,
+				Modified: raw.UpdatedAt.Time,
+				ReadOnly: true,
+			},
 		})
 	}
 	return result
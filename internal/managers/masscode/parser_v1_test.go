@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -27,6 +28,13 @@ func Test_parseDBFileV1(t *testing.T) {
 	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
 	assert.Equal(t, []string{"snipkit"}, snippets[0].GetTags())
 	assert.Len(t, snippets[0].GetParameters(), 3)
+	assert.Equal(t, model.SnippetMetadata{
+		Manager:  Key,
+		Source:   filepath.Join(testDataMassCodeV1Path, v1SnippetsFile),
+		Created:  time.UnixMilli(1645440619564),
+		Modified: time.UnixMilli(1645440672332),
+		ReadOnly: true,
+	}, snippets[0].GetMetadata())
 	assert.Equal(t,
 		"# some comment\necho \"one\"\n\necho \"two\"\n\necho \"three\"",
 		snippets[0].Format([]string{"one", "two", "three"},
@@ -2,6 +2,7 @@ package masscode
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/idutil"
@@ -10,9 +11,21 @@ import (
 
 var languageMapping = map[string]model.Language{
 	"shell":    model.LanguageBash,
+	"sh":       model.LanguageBash,
+	"bash":     model.LanguageBash,
 	"yaml":     model.LanguageYAML,
 	"markdown": model.LanguageMarkdown,
 	"toml":     model.LanguageTOML,
+	"text":     model.LanguageText,
+
+	"python":     model.LanguagePython,
+	"ruby":       model.LanguageRuby,
+	"javascript": model.LanguageJavaScript,
+	"powershell": model.LanguagePowerShell,
+	"perl":       model.LanguagePerl,
+	"sql":        model.LanguageSQL,
+	"dockerfile": model.LanguageDockerfile,
+	"json":       model.LanguageJSON,
 }
 
 type rawTag struct {
@@ -28,6 +41,8 @@ type rawSnippet struct {
 	TagIDs    []string `json:"tagIds"` // used for v2.
 	Tags      []string `json:"tags"`   // used for v1.
 	IsInTrash bool     `json:"isDeleted"`
+	CreatedAt rawTime  `json:"createdAt"`
+	UpdatedAt rawTime  `json:"updatedAt"`
 	Content   []struct {
 		Language string `json:"language"`
 		Value    string `json:"value"`
@@ -57,12 +72,40 @@ func parseDBFileV2(sys *system.System, path string) []model.Snippet {
 			tags:     toTagNames(raw.TagIDs, tagMap),
 			content:  raw.Content[0].Value,
 			language: mapLanguage(raw.Content[0].Language),
+			metadata: model.SnippetMetadata{
+				Manager:  Key,
+				Source:   path,
+				Created:  raw.CreatedAt.Time,
+				Modified: raw.UpdatedAt.Time,
+			},
 		})
 	}
 
 	return result
 }
 
+// rawTime is a timestamp in milliseconds. massCode v1 wraps the timestamp in an object: {"$$date": 1645439223437}.
+type rawTime struct {
+	time.Time
+}
+
+func (t *rawTime) UnmarshalJSON(data []byte) error {
+	var wrapped struct {
+		Date int64 `json:"$$date"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err == nil {
+		t.Time = time.UnixMilli(wrapped.Date)
+		return nil
+	}
+
+	var millis int64
+	if err := json.Unmarshal(data, &millis); err != nil {
+		return err
+	}
+	t.Time = time.UnixMilli(millis)
+	return nil
+}
+
 func toTagMapV2(tags []rawTag) map[string]string {
 	result := map[string]string{}
 	for i := range tags {
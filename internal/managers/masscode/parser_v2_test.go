@@ -2,6 +2,7 @@ package masscode
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -20,6 +21,12 @@ func Test_parseDBFileV2(t *testing.T) {
 	assert.Equal(t, "Another", snippets[0].GetTitle())
 	assert.Equal(t, model.LanguageText, snippets[0].GetLanguage())
 	assert.Equal(t, "echo Hello world", snippets[0].GetContent())
+	assert.Equal(t, model.SnippetMetadata{
+		Manager:  Key,
+		Source:   testDataLibraryV2Path,
+		Created:  time.UnixMilli(1645021327365),
+		Modified: time.UnixMilli(1645294503749),
+	}, snippets[0].GetMetadata())
 
 	assert.Equal(t, "Echo something", snippets[1].GetTitle())
 	assert.Equal(t, model.LanguageBash, snippets[1].GetLanguage())
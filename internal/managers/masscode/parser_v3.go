@@ -0,0 +1,191 @@
+package masscode
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"emperror.dev/errors"
+	_ "modernc.org/sqlite" // registers the sqlite driver used for massCode v3 databases
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+)
+
+const (
+	v3SnippetsQuery = `SELECT s.id, s.name, s.description, s.folderId, s.createdAt, s.updatedAt, c.id, c.label, c.value,
+		c.language
+		FROM snippets s JOIN snippet_contents c ON c.snippetId = s.id
+		WHERE s.isDeleted = 0
+		ORDER BY s.id, c.id`
+	v3FoldersQuery     = `SELECT id, name, parentId FROM folders`
+	v3SnippetTagsQuery = `SELECT st.snippetId, t.name FROM snippet_tags st JOIN tags t ON t.id = st.tagId`
+)
+
+type rawFolderV3 struct {
+	name     string
+	parentID sql.NullInt64
+}
+
+type rawFragmentV3 struct {
+	snippetID   int64
+	name        string
+	description sql.NullString
+	folderID    sql.NullInt64
+	createdAt   int64
+	updatedAt   int64
+	contentID   int64
+	label       sql.NullString
+	value       sql.NullString
+	language    sql.NullString
+}
+
+// parseDBFileV3 reads the snippets of the SQLite database used since massCode v3. Every content fragment of a
+// snippet is provided as a separate snippet. The folder of a snippet and all its parent folders are added as tags.
+func parseDBFileV3(path string) []model.Snippet {
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?mode=ro", (&url.URL{Path: path}).EscapedPath()))
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to open massCode database %s", path))
+	}
+	defer func() { _ = db.Close() }()
+
+	folders := queryFoldersV3(db)
+	snippetTags := querySnippetTagsV3(db)
+	fragments := queryFragmentsV3(db)
+
+	fragmentCount := map[int64]int{}
+	for _, f := range fragments {
+		fragmentCount[f.snippetID]++
+	}
+
+	var result []model.Snippet
+	for _, f := range fragments {
+		title := f.name
+		if fragmentCount[f.snippetID] > 1 && f.label.String != "" {
+			title = fmt.Sprintf("%s (%s)", f.name, f.label.String)
+		}
+
+		tags := append([]string{}, snippetTags[f.snippetID]...)
+		tags = append(tags, folderTagsV3(folders, f.folderID)...)
+
+		result = append(result, &snippetImpl{
+			id:       idutil.FormatSnippetID(fmt.Sprintf("%d-%d", f.snippetID, f.contentID), idPrefix),
+			title:    title,
+			tags:     dedupTags(tags),
+			content:  f.value.String,
+			language: mapLanguage(f.language.String),
+			metadata: model.SnippetMetadata{
+				Description: f.description.String,
+				Manager:     Key,
+				Source:      path,
+				Created:     time.UnixMilli(f.createdAt),
+				Modified:    time.UnixMilli(f.updatedAt),
+				ReadOnly:    true,
+			},
+		})
+	}
+
+	return result
+}
+
+func queryFoldersV3(db *sql.DB) map[int64]rawFolderV3 {
+	rows, err := db.Query(v3FoldersQuery)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to query massCode folders"))
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := map[int64]rawFolderV3{}
+	for rows.Next() {
+		var id int64
+		var folder rawFolderV3
+		if err = rows.Scan(&id, &folder.name, &folder.parentID); err != nil {
+			panic(err)
+		}
+		result[id] = folder
+	}
+	if err = rows.Err(); err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func querySnippetTagsV3(db *sql.DB) map[int64][]string {
+	rows, err := db.Query(v3SnippetTagsQuery)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to query massCode tags"))
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := map[int64][]string{}
+	for rows.Next() {
+		var snippetID int64
+		var name string
+		if err = rows.Scan(&snippetID, &name); err != nil {
+			panic(err)
+		}
+		result[snippetID] = append(result[snippetID], name)
+	}
+	if err = rows.Err(); err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func queryFragmentsV3(db *sql.DB) []rawFragmentV3 {
+	rows, err := db.Query(v3SnippetsQuery)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to query massCode snippets"))
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []rawFragmentV3
+	for rows.Next() {
+		var f rawFragmentV3
+		if err = rows.Scan(
+			&f.snippetID, &f.name, &f.description, &f.folderID, &f.createdAt, &f.updatedAt,
+			&f.contentID, &f.label, &f.value, &f.language,
+		); err != nil {
+			panic(err)
+		}
+		result = append(result, f)
+	}
+	if err = rows.Err(); err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// folderTagsV3 returns the name of the folder and the names of all its parent folders.
+func folderTagsV3(folders map[int64]rawFolderV3, folderID sql.NullInt64) []string {
+	var result []string
+	visited := map[int64]struct{}{}
+	for folderID.Valid {
+		if _, ok := visited[folderID.Int64]; ok {
+			break
+		}
+		visited[folderID.Int64] = struct{}{}
+
+		folder, ok := folders[folderID.Int64]
+		if !ok {
+			break
+		}
+		result = append(result, folder.name)
+		folderID = folder.parentID
+	}
+	return result
+}
+
+func dedupTags(tags []string) []string {
+	var result []string
+	seen := stringutil.StringSet{}
+	for _, tag := range tags {
+		if !seen.Contains(tag) {
+			seen.Add(tag)
+			result = append(result, tag)
+		}
+	}
+	return result
+}
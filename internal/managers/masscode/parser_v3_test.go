@@ -0,0 +1,40 @@
+package masscode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+func Test_parseDBFileV3(t *testing.T) {
+	snippets := parseDBFileV3(testDataLibraryV3Path)
+	assert.Len(t, snippets, 3)
+
+	assert.Equal(t, idutil.FormatSnippetID("1-1", idPrefix), snippets[0].GetID())
+	assert.Equal(t, "Echo something", snippets[0].GetTitle())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+	assert.Equal(t, []string{"snipkit"}, snippets[0].GetTags())
+	assert.Len(t, snippets[0].GetParameters(), 1)
+	assert.Equal(t, "Prints a message", snippets[0].GetMetadata().Description)
+	assert.Equal(t, time.UnixMilli(1700000000000), snippets[0].GetMetadata().Created)
+	assert.True(t, snippets[0].GetMetadata().ReadOnly)
+
+	assert.Equal(t, idutil.FormatSnippetID("2-2", idPrefix), snippets[1].GetID())
+	assert.Equal(t, "Pod logs (Follow)", snippets[1].GetTitle())
+	assert.Equal(t, model.LanguageBash, snippets[1].GetLanguage())
+	assert.Equal(t, []string{"Kubernetes", "DevOps"}, snippets[1].GetTags())
+	assert.Equal(t, `kubectl logs -f "${POD}"`, snippets[1].GetContent())
+
+	assert.Equal(t, "Pod logs (Notes)", snippets[2].GetTitle())
+	assert.Equal(t, model.LanguageMarkdown, snippets[2].GetLanguage())
+}
+
+func Test_parseDBFileV3_invalidFile(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = parseDBFileV3(testDataLibraryV2Path)
+	})
+}
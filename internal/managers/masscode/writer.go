@@ -0,0 +1,219 @@
+package masscode
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/google/uuid"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+const (
+	v2KeySnippets = "snippets"
+	v2KeyTags     = "tags"
+
+	v2FieldID        = "_id"
+	v2FieldName      = "name"
+	v2FieldContent   = "content"
+	v2FieldTagIDs    = "tagIds"
+	v2FieldUpdatedAt = "updatedAt"
+)
+
+type rawObject map[string]json.RawMessage
+
+// dbFileV2 holds the contents of a massCode v2 database file. Snippets and tags are kept as raw JSON objects so that
+// fields not known to snipkit are preserved when writing the file.
+type dbFileV2 struct {
+	root     rawObject
+	snippets []rawObject
+	tags     []rawObject
+}
+
+type rawFragment struct {
+	Label    string `json:"label"`
+	Language string `json:"language"`
+	Value    string `json:"value"`
+}
+
+// Writable returns true for massCode v2 only. The databases of other versions are read-only.
+func (m Manager) Writable() bool {
+	return m.config.Version == version2
+}
+
+func (m Manager) CreateSnippet(draft model.SnippetDraft) string {
+	db := m.readDBFileV2()
+
+	id := uuid.NewString()
+	now := time.Now().UnixMilli()
+	snippet := rawObject{
+		v2FieldID:        toRawJSON(id),
+		v2FieldName:      toRawJSON(draft.Title),
+		v2FieldContent:   toRawJSON([]rawFragment{{Label: "Fragment 1", Language: languageNameV2(draft.Language), Value: draft.Content}}),
+		v2FieldTagIDs:    toRawJSON(db.tagIDs(draft.Tags, now)),
+		"folderId":       toRawJSON(nil),
+		"isFavorites":    toRawJSON(false),
+		"isDeleted":      toRawJSON(false),
+		"createdAt":      toRawJSON(now),
+		v2FieldUpdatedAt: toRawJSON(now),
+	}
+	db.snippets = append(db.snippets, snippet)
+
+	m.writeDBFileV2(db)
+	return idutil.FormatSnippetID(id, idPrefix)
+}
+
+// UpdateSnippet updates the name, tags and the first content fragment of the snippet.
+func (m Manager) UpdateSnippet(id string, draft model.SnippetDraft) {
+	db := m.readDBFileV2()
+	snippet := db.snippets[db.snippetIndex(id)]
+	now := time.Now().UnixMilli()
+
+	var fragments []rawFragment
+	fromRawJSON(snippet[v2FieldContent], &fragments)
+	if len(fragments) == 0 {
+		fragments = append(fragments, rawFragment{Label: "Fragment 1"})
+	}
+	fragments[0].Value = draft.Content
+	fragments[0].Language = languageNameV2(draft.Language)
+
+	snippet[v2FieldName] = toRawJSON(draft.Title)
+	snippet[v2FieldContent] = toRawJSON(fragments)
+	snippet[v2FieldTagIDs] = toRawJSON(db.tagIDs(draft.Tags, now))
+	snippet[v2FieldUpdatedAt] = toRawJSON(now)
+
+	m.writeDBFileV2(db)
+}
+
+func (m Manager) DeleteSnippet(id string) {
+	db := m.readDBFileV2()
+	index := db.snippetIndex(id)
+	db.snippets = append(db.snippets[:index], db.snippets[index+1:]...)
+	m.writeDBFileV2(db)
+}
+
+func (m Manager) RenameSnippet(id string, title string) {
+	db := m.readDBFileV2()
+	snippet := db.snippets[db.snippetIndex(id)]
+	snippet[v2FieldName] = toRawJSON(title)
+	snippet[v2FieldUpdatedAt] = toRawJSON(time.Now().UnixMilli())
+	m.writeDBFileV2(db)
+}
+
+func (m Manager) dbFilePathV2() string {
+	return filepath.Join(m.system.UserHome(), defaultMassCodeHomePath, v2DatabaseFile)
+}
+
+func (m Manager) readDBFileV2() *dbFileV2 {
+	if m.config.Version != version2 {
+		panic(errors.Errorf("writing snippets is not supported for massCode %s", m.config.Version))
+	}
+
+	db := &dbFileV2{}
+	fromRawJSON(m.system.ReadFile(m.dbFilePathV2()), &db.root)
+	if raw, ok := db.root[v2KeySnippets]; ok {
+		fromRawJSON(raw, &db.snippets)
+	}
+	if raw, ok := db.root[v2KeyTags]; ok {
+		fromRawJSON(raw, &db.tags)
+	}
+	return db
+}
+
+func (m Manager) writeDBFileV2(db *dbFileV2) {
+	db.root[v2KeySnippets] = toRawJSON(db.snippets)
+	db.root[v2KeyTags] = toRawJSON(db.tags)
+
+	contents, err := json.MarshalIndent(db.root, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	m.system.WriteFile(m.dbFilePathV2(), contents)
+}
+
+func (db *dbFileV2) snippetIndex(id string) int {
+	for i, snippet := range db.snippets {
+		var rawID string
+		fromRawJSON(snippet[v2FieldID], &rawID)
+		if idutil.FormatSnippetID(rawID, idPrefix) == id {
+			return i
+		}
+	}
+	panic(errors.Errorf("snippet not found: %s", id))
+}
+
+// tagIDs returns the IDs of the tags with the given names. Tags which do not exist yet are created.
+func (db *dbFileV2) tagIDs(names []string, now int64) []string {
+	result := []string{}
+	for _, name := range names {
+		id := ""
+		for _, tag := range db.tags {
+			var tagName string
+			fromRawJSON(tag[v2FieldName], &tagName)
+			if tagName == name {
+				fromRawJSON(tag[v2FieldID], &id)
+				break
+			}
+		}
+
+		if id == "" {
+			id = uuid.NewString()
+			db.tags = append(db.tags, rawObject{
+				v2FieldID:        toRawJSON(id),
+				v2FieldName:      toRawJSON(name),
+				"createdAt":      toRawJSON(now),
+				v2FieldUpdatedAt: toRawJSON(now),
+			})
+		}
+		result = append(result, id)
+	}
+	return result
+}
+
+func languageNameV2(language model.Language) string {
+	switch language {
+	case model.LanguageBash, model.LanguageUnknown:
+		return "shell"
+	case model.LanguageYAML:
+		return "yaml"
+	case model.LanguageMarkdown:
+		return "markdown"
+	case model.LanguageTOML:
+		return "toml"
+	case model.LanguagePython:
+		return "python"
+	case model.LanguageRuby:
+		return "ruby"
+	case model.LanguageJavaScript:
+		return "javascript"
+	case model.LanguagePowerShell:
+		return "powershell"
+	case model.LanguagePerl:
+		return "perl"
+	case model.LanguageSQL:
+		return "sql"
+	case model.LanguageDockerfile:
+		return "dockerfile"
+	case model.LanguageJSON:
+		return "json"
+	default:
+		return "text"
+	}
+}
+
+func toRawJSON(value interface{}) json.RawMessage {
+	result, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func fromRawJSON(raw []byte, value interface{}) {
+	if err := json.Unmarshal(raw, value); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,82 @@
+package masscode
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func newWriterTestManager(t *testing.T) (*Manager, *system.System) {
+	t.Helper()
+	userHome := t.TempDir()
+	sys := testutil.NewTestSystem(system.WithUserHome(userHome))
+
+	dbFile := filepath.Join(userHome, defaultMassCodeHomePath, v2DatabaseFile)
+	sys.CreatePath(dbFile)
+	sys.WriteFile(dbFile, sys.ReadFile(testDataLibraryV2Path))
+
+	manager, err := NewManager(WithSystem(sys), WithConfig(Config{Enabled: true, Version: version2}))
+	assert.NoError(t, err)
+	return manager, sys
+}
+
+func Test_Writable(t *testing.T) {
+	assert.True(t, Manager{config: Config{Version: version2}}.Writable())
+	assert.False(t, Manager{config: Config{Version: version1}}.Writable())
+	assert.False(t, Manager{config: Config{Version: version3}}.Writable())
+}
+
+func Test_CreateSnippet(t *testing.T) {
+	manager, _ := newWriterTestManager(t)
+
+	id := manager.CreateSnippet(model.SnippetDraft{
+		Title: "New snippet", Content: "echo new", Tags: []string{"snipkit", "new-tag"}, Language: model.LanguageBash,
+	})
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 4)
+	assert.Equal(t, id, snippets[3].GetID())
+	assert.Equal(t, "New snippet", snippets[3].GetTitle())
+	assert.Equal(t, "echo new", snippets[3].GetContent())
+	assert.Equal(t, model.LanguageBash, snippets[3].GetLanguage())
+	assert.Equal(t, []string{"snipkit", "new-tag"}, snippets[3].GetTags())
+}
+
+func Test_UpdateRenameDeleteSnippet(t *testing.T) {
+	manager, sys := newWriterTestManager(t)
+	id := idutil.FormatSnippetID("aea48e0f-2df6-4383-8dda-2b0e1301cc4c", idPrefix)
+
+	manager.UpdateSnippet(id, model.SnippetDraft{Title: "Updated", Content: "echo updated", Language: model.LanguageBash})
+	snippets := manager.GetSnippets()
+	assert.Equal(t, "Updated", snippets[1].GetTitle())
+	assert.Equal(t, "echo updated", snippets[1].GetContent())
+	assert.Empty(t, snippets[1].GetTags())
+
+	manager.RenameSnippet(id, "Renamed")
+	assert.Equal(t, "Renamed", manager.GetSnippets()[1].GetTitle())
+
+	manager.DeleteSnippet(id)
+	assert.Len(t, manager.GetSnippets(), 2)
+
+	// unknown fields are preserved
+	contents := string(sys.ReadFile(manager.dbFilePathV2()))
+	assert.Contains(t, contents, `"folders"`)
+	assert.Contains(t, contents, `"isFavorites": false`)
+
+	assert.PanicsWithError(t, "snippet not found: unknown", func() {
+		manager.RenameSnippet("unknown", "foo")
+	})
+}
+
+func Test_CreateSnippet_unsupportedVersion(t *testing.T) {
+	manager := Manager{config: Config{Version: version1}}
+	assert.PanicsWithError(t, "writing snippets is not supported for massCode v1", func() {
+		manager.CreateSnippet(model.SnippetDraft{})
+	})
+}
@@ -0,0 +1,25 @@
+package navi
+
+import (
+	"path/filepath"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+type Config struct {
+	Enabled     bool     `yaml:"enabled" head_comment:"If set to false, navi cheatsheets will not be provided to you."`
+	CheatPaths  []string `yaml:"cheatPaths" head_comment:"List of directories (searched recursively) or single files holding navi cheatsheets (*.cheat)."`
+	IncludeTags []string `yaml:"includeTags" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you."`
+}
+
+func AutoDiscoveryConfig(system *system.System) *Config {
+	cheatsDir := defaultCheatsDir(system)
+	return &Config{
+		Enabled:    system.DirExists(cheatsDir),
+		CheatPaths: []string{cheatsDir},
+	}
+}
+
+func defaultCheatsDir(system *system.System) string {
+	return filepath.Join(system.UserDataHome(), defaultCheatsPath)
+}
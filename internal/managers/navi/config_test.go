@@ -0,0 +1,31 @@
+package navi
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		userDataDir string
+		enabled     bool
+	}{
+		{name: "found", userDataDir: "testdata/userdata", enabled: true},
+		{name: "not found", userDataDir: "testdata/not-found-dir", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testutil.NewTestSystem(system.WithUserDataDir(tt.userDataDir))
+			cfg := AutoDiscoveryConfig(s)
+			assert.Equal(t, tt.enabled, cfg.Enabled)
+			assert.Equal(t, []string{filepath.Join(tt.userDataDir, defaultCheatsPath)}, cfg.CheatPaths)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package navi
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	defaultCheatsPath                 = "navi/cheats"
+	cheatFileSuffix                   = ".cheat"
+	idPrefix          idutil.IDPrefix = "navi"
+)
@@ -0,0 +1,14 @@
+package navi
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("navi")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "navi - Interactive Cheatsheet Tool",
+		Description: "Use snippets from navi cheatsheets (*.cheat files)",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
@@ -0,0 +1,136 @@
+package navi
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Navi enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Navi cheat paths",
+		Value:   strings.Join(m.config.CheatPaths, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "Navi total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+
+	for _, cheatPath := range m.config.CheatPaths {
+		for _, filePath := range m.cheatFiles(cheatPath) {
+			metadata := model.SnippetMetadata{Manager: Key, Source: filePath, Modified: m.system.ModTime(filePath), ReadOnly: true}
+			for _, snippet := range parseCheat(filePath, string(m.system.ReadFile(filePath))) {
+				snippet.metadata = metadata
+				if tagutil.HasValidTag(validTags, snippet.GetTags()) {
+					result = append(result, snippet)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+// cheatFiles returns all cheat files for the given path. If the path points to a directory, it is searched recursively.
+func (m *Manager) cheatFiles(path string) []string {
+	if !m.system.DirExists(path) {
+		if !m.system.FileExists(path) {
+			log.Warn().Str("path", path).Msg("navi cheat path does not exist")
+			return nil
+		}
+		return []string{path}
+	}
+
+	var result []string
+	entries, err := afero.ReadDir(m.system.Fs, path)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+		if entry.IsDir() {
+			result = append(result, m.cheatFiles(entryPath)...)
+		} else if filepath.Ext(entry.Name()) == cheatFileSuffix {
+			result = append(result, entryPath)
+		}
+	}
+
+	return result
+}
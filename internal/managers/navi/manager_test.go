@@ -0,0 +1,87 @@
+package navi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+const testDataCheatsDir = "testdata/cheats"
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{
+		Enabled:    true,
+		CheatPaths: []string{testDataCheatsDir},
+	}
+
+	system := testutil.NewTestSystem()
+	manager, err := NewManager(WithSystem(system), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "Navi enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "Navi cheat paths", info[1].Key)
+	assert.Equal(t, testDataCheatsDir, info[1].Value)
+
+	assert.Equal(t, "Navi total number of snippets", info[2].Key)
+	assert.Equal(t, "4", info[2].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name                     string
+		cheatPaths               []string
+		includeTags              []string
+		expectedNumberOfSnippets int
+	}{
+		{name: "directory", cheatPaths: []string{testDataCheatsDir}, expectedNumberOfSnippets: 4},
+		{name: "single file", cheatPaths: []string{testDataCheatFile}, expectedNumberOfSnippets: 3},
+		{name: "include tag", cheatPaths: []string{testDataCheatsDir}, includeTags: []string{"git"}, expectedNumberOfSnippets: 2},
+		{name: "not existing", cheatPaths: []string{"testdata/not-existing"}, expectedNumberOfSnippets: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Enabled: true, CheatPaths: tt.cheatPaths, IncludeTags: tt.includeTags}
+			manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+			snippets := manager.GetSnippets()
+			assert.Len(t, snippets, tt.expectedNumberOfSnippets)
+			for _, snippet := range snippets {
+				assert.Equal(t, Key, snippet.GetMetadata().Manager)
+				assert.True(t, snippet.GetMetadata().ReadOnly)
+			}
+		})
+	}
+}
+
+func Test_Format(t *testing.T) {
+	config := Config{Enabled: true, CheatPaths: []string{testDataCheatFile}}
+	manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+	snippet := manager.GetSnippets()[0]
+	assert.Equal(t, "git checkout main", snippet.Format([]string{"main"}, model.SnippetFormatOptions{}))
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
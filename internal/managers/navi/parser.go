@@ -0,0 +1,298 @@
+package navi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+const (
+	prefixTags        = "%"
+	prefixDescription = "#"
+	prefixVariable    = "$"
+	prefixExtends     = "@"
+	prefixComment     = ";"
+
+	variableOptionsSeparator = "---"
+)
+
+var (
+	variableRegex             = regexp.MustCompile(`<(\w[\w-]*)>`)
+	literalValuesCommandRegex = regexp.MustCompile(`^(?:echo(?:\s+-[neE]+)*|printf)\s+(.+)$`)
+
+	// variableOptionsWithArgument lists the navi variable options which expect an argument.
+	variableOptionsWithArgument = map[string]struct{}{
+		"--column": {}, "--delimiter": {}, "--query": {}, "--header": {}, "--filter": {},
+		"--map": {}, "--preview": {}, "--preview-window": {}, "--fzf-overrides": {}, "--header-lines": {},
+	}
+)
+
+type variableOptions struct {
+	column    int
+	delimiter string
+	query     string
+	header    string
+}
+
+// cheatParser holds the state while reading a single cheat file line by line. Variables are scoped to the
+// section started by a tags line, which is why snippets are collected per section before assigning parameters.
+type cheatParser struct {
+	path      string
+	tags      []string
+	title     string
+	commands  []string
+	section   []*snippetImpl
+	variables map[string]string
+	result    []*snippetImpl
+}
+
+func parseCheat(path string, contents string) []*snippetImpl {
+	p := cheatParser{path: path, variables: map[string]string{}}
+
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			p.flushSnippet()
+		case strings.HasPrefix(trimmed, prefixTags):
+			p.flushSection()
+			p.tags = parseTags(strings.TrimPrefix(trimmed, prefixTags))
+		case strings.HasPrefix(trimmed, prefixDescription):
+			p.flushSnippet()
+			p.title = strings.TrimSpace(strings.TrimPrefix(trimmed, prefixDescription))
+		case strings.HasPrefix(trimmed, prefixVariable):
+			p.flushSnippet()
+			if name, definition, ok := strings.Cut(strings.TrimPrefix(trimmed, prefixVariable), ":"); ok {
+				p.variables[strings.TrimSpace(name)] = strings.TrimSpace(definition)
+			}
+		case strings.HasPrefix(trimmed, prefixExtends), strings.HasPrefix(trimmed, prefixComment):
+			p.flushSnippet()
+		default:
+			p.commands = append(p.commands, line)
+		}
+	}
+
+	p.flushSection()
+	return p.result
+}
+
+func (p *cheatParser) flushSnippet() {
+	if len(p.commands) == 0 {
+		return
+	}
+
+	title := p.title
+	if title == "" {
+		title = strings.TrimSpace(p.commands[0])
+	}
+
+	p.section = append(p.section, &snippetImpl{
+		id:      idutil.FormatSnippetID(fmt.Sprintf("%s#%d", p.path, len(p.result)+len(p.section)), idPrefix),
+		title:   title,
+		content: strings.Join(p.commands, "\n"),
+		tags:    p.tags,
+	})
+
+	p.title = ""
+	p.commands = nil
+}
+
+func (p *cheatParser) flushSection() {
+	p.flushSnippet()
+	for _, snippet := range p.section {
+		snippet.parameters = parseParameters(snippet.content, p.variables)
+		p.result = append(p.result, snippet)
+	}
+	p.section = nil
+	p.variables = map[string]string{}
+}
+
+func parseTags(line string) []string {
+	result := []string{}
+	for _, tag := range strings.Split(line, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// parseParameters returns a parameter for each distinct <variable> in the order of first appearance. If a
+// suggestion line ($ variable: command) exists for a variable, its values and options are applied.
+func parseParameters(content string, variables map[string]string) []model.Parameter {
+	var result []model.Parameter
+	visited := map[string]struct{}{}
+
+	for _, match := range variableRegex.FindAllStringSubmatch(content, -1) {
+		key := match[1]
+		if _, ok := visited[key]; ok {
+			continue
+		}
+		visited[key] = struct{}{}
+
+		parameter := model.Parameter{Key: key, Name: key}
+		if definition, ok := variables[key]; ok {
+			applyVariableDefinition(&parameter, definition)
+		}
+		result = append(result, parameter)
+	}
+
+	return result
+}
+
+func applyVariableDefinition(parameter *model.Parameter, definition string) {
+	command, rawOptions, _ := strings.Cut(definition, variableOptionsSeparator)
+	command = strings.TrimSpace(command)
+	options := parseVariableOptions(splitArgs(rawOptions))
+
+	parameter.DefaultValue = options.query
+	parameter.Description = options.header
+
+	if values, ok := literalValues(command); ok {
+		parameter.Values = selectColumn(values, options)
+	}
+}
+
+func parseVariableOptions(args []string) variableOptions {
+	var result variableOptions
+
+	for i := 0; i < len(args); i++ {
+		name := args[i]
+		if _, hasArgument := variableOptionsWithArgument[name]; !hasArgument {
+			continue
+		}
+		if i+1 >= len(args) {
+			break
+		}
+		i++
+		value := args[i]
+
+		switch name {
+		case "--column":
+			if column, err := strconv.Atoi(value); err == nil {
+				result.column = column
+			}
+		case "--delimiter":
+			result.delimiter = value
+		case "--query":
+			result.query = value
+		case "--header":
+			result.header = value
+		}
+	}
+
+	return result
+}
+
+// literalValues extracts the suggestions of a variable if they are defined statically via echo or printf.
+// Suggestion commands which depend on the environment (pipes, substitutions, other variables, ...) are not
+// evaluated.
+func literalValues(command string) ([]string, bool) {
+	if strings.ContainsAny(command, "|;&`$<>") {
+		return nil, false
+	}
+
+	match := literalValuesCommandRegex.FindStringSubmatch(command)
+	if match == nil {
+		return nil, false
+	}
+
+	joined := strings.ReplaceAll(strings.Join(splitArgs(match[1]), " "), `\n`, "\n")
+
+	var values []string
+	for _, value := range strings.Split(joined, "\n") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values, len(values) > 0
+}
+
+func selectColumn(values []string, options variableOptions) []string {
+	if options.column <= 0 {
+		return values
+	}
+
+	var delimiter *regexp.Regexp
+	if options.delimiter != "" {
+		var err error
+		if delimiter, err = regexp.Compile(options.delimiter); err != nil {
+			delimiter = regexp.MustCompile(regexp.QuoteMeta(options.delimiter))
+		}
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		var fields []string
+		if delimiter != nil {
+			fields = delimiter.Split(value, -1)
+		} else {
+			fields = strings.Fields(value)
+		}
+		if options.column <= len(fields) {
+			result = append(result, strings.TrimSpace(fields[options.column-1]))
+		}
+	}
+	return result
+}
+
+// splitArgs splits the given string by whitespace while respecting single and double quotes.
+func splitArgs(s string) []string {
+	var result []string
+	var current strings.Builder
+	var quote rune
+	inArg := false
+
+	for _, r := range s {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				result = append(result, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		result = append(result, current.String())
+	}
+	return result
+}
+
+func formatContent(content string, parameters []model.Parameter, values []string) string {
+	if len(values) == 0 {
+		return content
+	}
+
+	valuesByKey := map[string]string{}
+	for i := range parameters {
+		if i < len(values) {
+			valuesByKey[parameters[i].Key] = values[i]
+		}
+	}
+
+	return variableRegex.ReplaceAllStringFunc(content, func(match string) string {
+		if value, ok := valuesByKey[variableRegex.FindStringSubmatch(match)[1]]; ok {
+			return value
+		}
+		return match
+	})
+}
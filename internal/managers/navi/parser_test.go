@@ -0,0 +1,96 @@
+package navi
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const testDataCheatFile = "testdata/cheats/git.cheat"
+
+func Test_parseCheat(t *testing.T) {
+	snippets := parseCheat(testDataCheatFile, readTestdata(t, testDataCheatFile))
+	assert.Len(t, snippets, 3)
+
+	assert.Equal(t, "Change branch", snippets[0].GetTitle())
+	assert.Equal(t, "git checkout <branch>", snippets[0].GetContent())
+	assert.Equal(t, []string{"git", "code"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+	assert.Equal(t, []model.Parameter{{Key: "branch", Name: "branch"}}, snippets[0].GetParameters())
+
+	assert.Equal(t, "Commit with message", snippets[1].GetTitle())
+	assert.Equal(t, []model.Parameter{
+		{Key: "message", Name: "message", DefaultValue: "chore: ", Values: []string{"fix:"}},
+	}, snippets[1].GetParameters())
+
+	assert.Equal(t, "Run container with port mapping", snippets[2].GetTitle())
+	assert.Equal(t, "docker run -p <port>:<port> <image> \\\n  --restart <policy>", snippets[2].GetContent())
+	assert.Equal(t, []string{"docker"}, snippets[2].GetTags())
+	assert.Equal(t, []model.Parameter{
+		{Key: "port", Name: "port", Values: []string{"8080", "9090"}},
+		{Key: "image", Name: "image"},
+		{Key: "policy", Name: "policy", Description: "Restart policy", Values: []string{"no", "on-failure"}},
+	}, snippets[2].GetParameters())
+
+	assert.NotEqual(t, snippets[0].GetID(), snippets[1].GetID())
+}
+
+func Test_parseCheat_titleFallback(t *testing.T) {
+	snippets := parseCheat("dummy.cheat", "% foo\n\nls -la <dir>\n")
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "ls -la <dir>", snippets[0].GetTitle())
+}
+
+func Test_parseCheat_variablesScopedToSection(t *testing.T) {
+	snippets := parseCheat("dummy.cheat", "% a\n\necho <x>\n\n$ x: echo 1\n\n% b\n\necho <x>\n")
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, []string{"1"}, snippets[0].GetParameters()[0].Values)
+	assert.Empty(t, snippets[1].GetParameters()[0].Values)
+}
+
+func Test_literalValues(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected []string
+		ok       bool
+	}{
+		{command: `echo -e "a\nb"`, expected: []string{"a", "b"}, ok: true},
+		{command: `printf 'x\ny\n'`, expected: []string{"x", "y"}, ok: true},
+		{command: `echo foo`, expected: []string{"foo"}, ok: true},
+		{command: `docker ps --format '{{.Names}}'`, ok: false},
+		{command: `echo $HOME`, ok: false},
+		{command: `echo "a" | sort`, ok: false},
+		{command: `echo <other>`, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			values, ok := literalValues(tt.command)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func Test_selectColumn(t *testing.T) {
+	values := []string{"a:1", "b:2", "c"}
+	assert.Equal(t, []string{"1", "2"}, selectColumn(values, variableOptions{column: 2, delimiter: ":"}))
+	assert.Equal(t, values, selectColumn(values, variableOptions{}))
+}
+
+func Test_formatContent(t *testing.T) {
+	parameters := []model.Parameter{{Key: "port"}, {Key: "image"}}
+	content := "docker run -p <port>:<port> <image> <unknown>"
+
+	assert.Equal(t, "docker run -p 80:80 nginx <unknown>", formatContent(content, parameters, []string{"80", "nginx"}))
+	assert.Equal(t, content, formatContent(content, parameters, nil))
+}
+
+func readTestdata(t *testing.T, path string) string {
+	t.Helper()
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return string(contents)
+}
@@ -0,0 +1,17 @@
+package notebook
+
+type Config struct {
+	Enabled            bool     `yaml:"enabled" head_comment:"If set to false, the code blocks of markdown notes will not be provided to you."`
+	Paths              []string `yaml:"paths" head_comment:"List of directories (searched recursively) or single markdown files."`
+	InfoStrings        []string `yaml:"infoStrings" head_comment:"Only fenced code blocks with one of the listed info strings (the language after the opening fence) are considered."`
+	IncludeTags        []string `yaml:"includeTags" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you. Tags are read from the front matter or defined as #tag within the note."`
+	HideTitleInPreview bool     `yaml:"hideTitleInPreview" head_comment:"If set to true, the title header comment of a code block will not be shown in the preview window."`
+}
+
+func AutoDiscoveryConfig() *Config {
+	return &Config{
+		Enabled:     false,
+		Paths:       []string{"/path/to/markdown/notes"},
+		InfoStrings: defaultInfoStrings,
+	}
+}
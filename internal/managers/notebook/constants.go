@@ -0,0 +1,35 @@
+package notebook
+
+import (
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+const (
+	markdownFileSuffix   = ".md"
+	frontMatterDelimiter = "---"
+
+	idPrefix idutil.IDPrefix = "notebook"
+)
+
+var defaultInfoStrings = []string{"bash", "sh", "shell", "zsh"}
+
+// languageMapping maps the info string of a fenced code block to the language of the snippet. Code blocks with any
+// other info string are considered shell scripts.
+var languageMapping = map[string]model.Language{
+	"python":     model.LanguagePython,
+	"py":         model.LanguagePython,
+	"ruby":       model.LanguageRuby,
+	"rb":         model.LanguageRuby,
+	"javascript": model.LanguageJavaScript,
+	"js":         model.LanguageJavaScript,
+	"node":       model.LanguageJavaScript,
+	"powershell": model.LanguagePowerShell,
+	"pwsh":       model.LanguagePowerShell,
+	"perl":       model.LanguagePerl,
+	"sql":        model.LanguageSQL,
+	"dockerfile": model.LanguageDockerfile,
+	"json":       model.LanguageJSON,
+	"yaml":       model.LanguageYAML,
+	"toml":       model.LanguageTOML,
+}
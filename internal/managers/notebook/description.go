@@ -0,0 +1,14 @@
+package notebook
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("markdownNotebook")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "Markdown Notebook",
+		Description: "Use the shell code blocks of markdown notes (e.g., runbooks or an Obsidian vault)",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
@@ -0,0 +1,146 @@
+package notebook
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Markdown notebook enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Markdown notebook paths",
+		Value:   strings.Join(m.config.Paths, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "Markdown notebook total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+	infoStrings := stringutil.NewStringSet(nil)
+	for _, info := range m.config.InfoStrings {
+		infoStrings.Add(strings.ToLower(info))
+	}
+
+	for _, path := range m.config.Paths {
+		for _, filePath := range m.noteFiles(path) {
+			name := strings.TrimSuffix(filepath.Base(filePath), markdownFileSuffix)
+			contents := string(m.system.ReadFile(filePath))
+			metadata := model.SnippetMetadata{Manager: Key, Source: filePath, Modified: m.system.ModTime(filePath), ReadOnly: true}
+			for _, snippet := range parseNote(filePath, name, contents, infoStrings, m.config.HideTitleInPreview) {
+				snippet.metadata = metadata
+				if tagutil.HasValidTag(validTags, snippet.GetTags()) {
+					result = append(result, snippet)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+// noteFiles returns all markdown files for the given path. If the path points to a directory, it is searched
+// recursively. Hidden files and directories (e.g., .obsidian or .git) are ignored.
+func (m *Manager) noteFiles(path string) []string {
+	if !m.system.DirExists(path) {
+		if !m.system.FileExists(path) {
+			log.Warn().Str("path", path).Msg("markdown notebook path does not exist")
+			return nil
+		}
+		return []string{path}
+	}
+
+	var result []string
+	entries, err := afero.ReadDir(m.system.Fs, path)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		entryPath := filepath.Join(path, entry.Name())
+		if entry.IsDir() {
+			result = append(result, m.noteFiles(entryPath)...)
+		} else if strings.EqualFold(filepath.Ext(entry.Name()), markdownFileSuffix) {
+			result = append(result, entryPath)
+		}
+	}
+
+	return result
+}
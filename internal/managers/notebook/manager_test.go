@@ -0,0 +1,77 @@
+package notebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+const testDataNotesDir = "testdata/notes"
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{Enabled: true, Paths: []string{testDataNotesDir}, InfoStrings: defaultInfoStrings}
+
+	manager, err := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "Markdown notebook enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "Markdown notebook paths", info[1].Key)
+	assert.Equal(t, testDataNotesDir, info[1].Value)
+
+	assert.Equal(t, "Markdown notebook total number of snippets", info[2].Key)
+	assert.Equal(t, "4", info[2].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name                     string
+		paths                    []string
+		infoStrings              []string
+		includeTags              []string
+		expectedNumberOfSnippets int
+	}{
+		{name: "directory", paths: []string{testDataNotesDir}, infoStrings: defaultInfoStrings, expectedNumberOfSnippets: 4},
+		{name: "single file", paths: []string{testDataDeployNote}, infoStrings: defaultInfoStrings, expectedNumberOfSnippets: 3},
+		{name: "info strings", paths: []string{testDataNotesDir}, infoStrings: []string{"YAML"}, expectedNumberOfSnippets: 1},
+		{name: "include tags", paths: []string{testDataNotesDir}, infoStrings: defaultInfoStrings, includeTags: []string{"misc"}, expectedNumberOfSnippets: 1},
+		{name: "not existing", paths: []string{"testdata/not-existing"}, infoStrings: defaultInfoStrings, expectedNumberOfSnippets: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Enabled: true, Paths: tt.paths, InfoStrings: tt.infoStrings, IncludeTags: tt.includeTags}
+			manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+			snippets := manager.GetSnippets()
+			assert.Len(t, snippets, tt.expectedNumberOfSnippets)
+			for _, snippet := range snippets {
+				assert.Equal(t, Key, snippet.GetMetadata().Manager)
+				assert.True(t, snippet.GetMetadata().ReadOnly)
+			}
+		})
+	}
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
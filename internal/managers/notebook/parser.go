@@ -0,0 +1,193 @@
+package notebook
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/titleheader"
+)
+
+var (
+	headingRegex   = regexp.MustCompile(`^ {0,3}#{1,6}\s+(.*?)(\s+#+)?\s*$`)
+	fenceRegex     = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})\\s*([^`\\s]*)")
+	inlineTagRegex = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_/-]*[\p{L}_/-][\p{L}\p{N}_/-]*)`)
+)
+
+type fence struct {
+	indent   int
+	marker   string
+	info     string
+	heading  string
+	contents []string
+}
+
+// parseNote returns a snippet for each fenced code block whose info string is contained in the given set. The title
+// of a snippet is taken from a title header within the block or the nearest heading above the block otherwise.
+func parseNote(path string, name string, contents string, infoStrings stringutil.StringSet, hideTitle bool) []*snippetImpl {
+	matter, body := splitFrontMatter(contents)
+	tags := uniqueTags(append(frontMatterTags(matter), inlineTags(body)...))
+
+	var result []*snippetImpl
+	for _, block := range fencedBlocks(body) {
+		if !infoStrings.Contains(strings.ToLower(block.info)) {
+			continue
+		}
+
+		content := strings.Join(block.contents, "\n")
+		title := block.heading
+		if t, ok := titleheader.ParseTitleFromHeader(content); ok {
+			title = t
+		}
+		if title == "" {
+			title = name
+		}
+		if hideTitle {
+			content = titleheader.PruneTitleHeader(content)
+		}
+
+		result = append(result, &snippetImpl{
+			id:       idutil.FormatSnippetID(fmt.Sprintf("%s#%d", path, len(result)), idPrefix),
+			title:    title,
+			content:  content,
+			tags:     tags,
+			language: mapLanguage(block.info),
+		})
+	}
+
+	return result
+}
+
+func mapLanguage(info string) model.Language {
+	if language, ok := languageMapping[strings.ToLower(info)]; ok {
+		return language
+	}
+	return model.LanguageBash
+}
+
+func splitFrontMatter(contents string) (string, string) {
+	lines := strings.Split(contents, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return "", contents
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == frontMatterDelimiter {
+			return strings.Join(lines[1:i], "\n"), strings.Join(lines[i+1:], "\n")
+		}
+	}
+
+	return "", contents
+}
+
+// frontMatterTags reads the tags of the front matter. Tags may be defined as list or as string separated by commas
+// or whitespace (both variants are supported by Obsidian). Invalid front matter is ignored.
+func frontMatterTags(matter string) []string {
+	var parsed struct {
+		Tags interface{} `yaml:"tags"`
+	}
+	if err := yaml.Unmarshal([]byte(matter), &parsed); err != nil {
+		return []string{}
+	}
+
+	var values []string
+	switch tags := parsed.Tags.(type) {
+	case string:
+		values = strings.FieldsFunc(tags, func(r rune) bool { return r == ',' || r == ' ' })
+	case []interface{}:
+		for _, tag := range tags {
+			values = append(values, fmt.Sprint(tag))
+		}
+	}
+
+	result := []string{}
+	for _, value := range values {
+		if value = strings.TrimPrefix(strings.TrimSpace(value), "#"); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// inlineTags returns all #tags defined within the text of a note. Headings and code blocks are ignored.
+func inlineTags(body string) []string {
+	var result []string
+	inFence := ""
+
+	for _, line := range strings.Split(body, "\n") {
+		if match := fenceRegex.FindStringSubmatch(line); match != nil {
+			if inFence == "" {
+				inFence = match[2]
+			} else if isClosingFence(line, inFence) {
+				inFence = ""
+			}
+			continue
+		}
+		if inFence != "" || headingRegex.MatchString(line) {
+			continue
+		}
+		for _, match := range inlineTagRegex.FindAllStringSubmatch(line, -1) {
+			result = append(result, match[1])
+		}
+	}
+
+	return result
+}
+
+// fencedBlocks returns all fenced code blocks of a note together with their nearest heading.
+func fencedBlocks(body string) []fence {
+	var result []fence
+	var current *fence
+	heading := ""
+
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		if current != nil {
+			if isClosingFence(line, current.marker) {
+				result = append(result, *current)
+				current = nil
+			} else {
+				current.contents = append(current.contents, trimIndent(line, current.indent))
+			}
+			continue
+		}
+
+		if match := fenceRegex.FindStringSubmatch(line); match != nil {
+			current = &fence{indent: len(match[1]), marker: match[2], info: match[3], heading: heading}
+		} else if match := headingRegex.FindStringSubmatch(line); match != nil {
+			heading = strings.TrimSpace(match[1])
+		}
+	}
+
+	return result
+}
+
+func isClosingFence(line string, marker string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, marker) && strings.Trim(trimmed, marker[:1]) == ""
+}
+
+func trimIndent(line string, indent int) string {
+	for i := 0; i < indent && strings.HasPrefix(line, " "); i++ {
+		line = line[1:]
+	}
+	return line
+}
+
+func uniqueTags(tags []string) []string {
+	result := []string{}
+	visited := stringutil.NewStringSet(nil)
+	for _, tag := range tags {
+		if !visited.Contains(tag) {
+			visited.Add(tag)
+			result = append(result, tag)
+		}
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+package notebook
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+)
+
+const testDataDeployNote = "testdata/notes/runbooks/deploy.md"
+
+func Test_parseNote(t *testing.T) {
+	contents, err := os.ReadFile(testDataDeployNote)
+	assert.NoError(t, err)
+
+	snippets := parseNote(testDataDeployNote, "deploy", string(contents), stringutil.NewStringSet(defaultInfoStrings), false)
+	assert.Len(t, snippets, 3)
+
+	assert.Equal(t, "Build the image", snippets[0].GetTitle())
+	assert.Equal(t, "docker build -t app:latest .", snippets[0].GetContent())
+	assert.Equal(t, []string{"runbook", "deploy", "production"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+
+	assert.Equal(t, "Roll out the new version", snippets[1].GetTitle())
+	assert.Contains(t, snippets[1].GetContent(), "# Roll out the new version")
+	parameters := snippets[1].GetParameters()
+	assert.Len(t, parameters, 1)
+	assert.Equal(t, "Environment", parameters[0].Name)
+	assert.Equal(t, []string{"staging", "production"}, parameters[0].Values)
+
+	assert.Equal(t, "Roll out", snippets[2].GetTitle())
+	assert.Equal(t, "echo \"inside tilde fence\"\n```\nstill inside", snippets[2].GetContent())
+
+	assert.NotEqual(t, snippets[0].GetID(), snippets[1].GetID())
+}
+
+func Test_parseNote_hideTitle(t *testing.T) {
+	contents, err := os.ReadFile(testDataDeployNote)
+	assert.NoError(t, err)
+
+	snippets := parseNote(testDataDeployNote, "deploy", string(contents), stringutil.NewStringSet([]string{"sh"}), true)
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "Roll out the new version", snippets[0].GetTitle())
+	assert.NotContains(t, snippets[0].GetContent(), "# Roll out the new version")
+}
+
+func Test_parseNote_titleFallback(t *testing.T) {
+	snippets := parseNote("foo.md", "foo", "```bash\necho foo\n```", stringutil.NewStringSet(defaultInfoStrings), false)
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "foo", snippets[0].GetTitle())
+	assert.Empty(t, snippets[0].GetTags())
+}
+
+func Test_parseNote_language(t *testing.T) {
+	contents := "```python\nprint('foo')\n```\n\n```sh\necho foo\n```"
+	snippets := parseNote("foo.md", "foo", contents, stringutil.NewStringSet([]string{"python", "sh"}), false)
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, model.LanguagePython, snippets[0].GetLanguage())
+	assert.Equal(t, model.LanguageBash, snippets[1].GetLanguage())
+}
+
+func Test_frontMatterTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		matter   string
+		expected []string
+	}{
+		{name: "list", matter: "tags: [a, '#b']", expected: []string{"a", "b"}},
+		{name: "string", matter: "tags: a, b c", expected: []string{"a", "b", "c"}},
+		{name: "none", matter: "title: foo", expected: []string{}},
+		{name: "invalid", matter: "tags: [", expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, frontMatterTags(tt.matter))
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"emperror.dev/errors"
 
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/index"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/stringutil"
 	"github.com/lemoony/snipkit/internal/utils/system"
@@ -14,6 +16,7 @@ import (
 
 type Manager struct {
 	system *system.System
+	cache  cache.Cache
 	config Config
 }
 
@@ -42,6 +45,13 @@ func WithConfig(config Config) Option {
 	})
 }
 
+// WithCache sets the cache which holds the index of parsed snippet files.
+func WithCache(cache cache.Cache) Option {
+	return optionFunc(func(p *Manager) {
+		p.cache = cache
+	})
+}
+
 func NewManager(options ...Option) (*Manager, error) {
 	manager := &Manager{}
 	for _, o := range options {
@@ -81,17 +91,22 @@ func (m Manager) Info() []model.InfoLine {
 }
 
 func (m *Manager) GetSnippets() []model.Snippet {
+	snippetIndex := index.Load(m.cache, Key, m.config, index.WithFormatFunc(formatIndexedContent))
+
 	var result []model.Snippet
 	validTags := stringutil.NewStringSet(m.config.IncludeTags)
 	for _, libPath := range m.config.LibraryPaths {
-		contents := m.system.ReadFile(libPath)
-		snippets := parseSnippetsFromTOML(string(contents))
+		snippets := snippetIndex.Snippets(libPath, index.FileFingerprint(m.system.Fs, libPath), func() []model.Snippet {
+			return parseSnippetsFromTOML(libPath, string(m.system.ReadFile(libPath)), m.system.ModTime(libPath))
+		})
 		for _, snippet := range snippets {
 			if tagutil.HasValidTag(validTags, snippet.GetTags()) {
 				result = append(result, snippet)
 			}
 		}
 	}
+
+	snippetIndex.Save()
 	return result
 }
 
@@ -10,10 +10,11 @@ type snippetImpl struct {
 	title    string
 	content  string
 	language model.Language
+	metadata model.SnippetMetadata
 }
 
 func (s snippetImpl) GetID() string {
-	return "unused"
+	return s.id
 }
 
 func (s snippetImpl) GetTitle() string {
@@ -39,3 +40,7 @@ func (s snippetImpl) GetParameters() []model.Parameter {
 func (s snippetImpl) Format(values []string, _ model.SnippetFormatOptions) string {
 	return formatContent(s.content, values)
 }
+
+func (s snippetImpl) GetMetadata() model.SnippetMetadata {
+	return s.metadata
+}
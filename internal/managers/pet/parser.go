@@ -1,10 +1,13 @@
 package pet
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/spf13/afero"
@@ -20,13 +23,14 @@ var (
 )
 
 type tomlSnippetsFile struct {
-	Snippets []tomlSnippet
+	Snippets []tomlSnippet `toml:"snippets"`
 }
 
 type tomlSnippet struct {
 	Description string   `toml:"description"`
 	Command     string   `toml:"command"`
-	Tags        []string `toml:"tag"`
+	Tags        []string `toml:"tag,omitempty"`
+	Output      string   `toml:"output"`
 }
 
 func parseSnippetFilePaths(s *system.System) ([]string, error) {
@@ -66,27 +70,50 @@ func parseConfigForSnippetFilePaths(configContents string) ([]string, error) {
 	return paths, nil
 }
 
-func parseSnippetsFromTOML(contents string) []model.Snippet {
+func parseSnippetsFromTOML(path string, contents string, modified time.Time) []model.Snippet {
+	snippetsFile := decodeSnippetsFile(contents)
+	metadata := model.SnippetMetadata{Manager: Key, Source: path, Modified: modified}
+
+	ids := snippetIDs(path, snippetsFile.Snippets)
+	result := make([]model.Snippet, len(snippetsFile.Snippets))
+	for i := range snippetsFile.Snippets {
+		result[i] = mapToSnippet(ids[i], snippetsFile.Snippets[i], metadata)
+	}
+	return result
+}
+
+func decodeSnippetsFile(contents string) tomlSnippetsFile {
 	var snippetsFile tomlSnippetsFile
 	_, err := toml.Decode(contents, &snippetsFile)
 	if err != nil {
 		panic(err)
 	}
+	return snippetsFile
+}
 
-	result := make([]model.Snippet, len(snippetsFile.Snippets))
-	for i := range snippetsFile.Snippets {
-		result[i] = mapToSnippet(snippetsFile.Snippets[i])
+// snippetIDs returns the IDs of all snippets of the snippet file. Since pet snippets have no ID, it is derived from the
+// description and the command so that it does not change if other snippets are added or removed. Identical snippets
+// are distinguished by their occurrence.
+func snippetIDs(path string, snippets []tomlSnippet) []string {
+	result := make([]string, len(snippets))
+	occurrences := map[string]int{}
+	for i, snippet := range snippets {
+		hash := sha256.Sum256([]byte(snippet.Description + "\x00" + snippet.Command))
+		key := hex.EncodeToString(hash[:8])
+		result[i] = idutil.FormatSnippetID(fmt.Sprintf("%s#%s#%d", path, key, occurrences[key]), idPrefix)
+		occurrences[key]++
 	}
 	return result
 }
 
-func mapToSnippet(raw tomlSnippet) model.Snippet {
+func mapToSnippet(id string, raw tomlSnippet, metadata model.SnippetMetadata) model.Snippet {
 	return &snippetImpl{
-		id:       idutil.FormatSnippetID("not_used", idPrefix),
+		id:       id,
 		title:    raw.Description,
 		content:  raw.Command,
 		tags:     raw.Tags,
 		language: model.LanguageBash,
+		metadata: metadata,
 	}
 }
 
@@ -117,6 +144,11 @@ func parseParameters(command string) []model.Parameter {
 	return result
 }
 
+// formatIndexedContent formats snippets restored from the index since pet uses its own parameter syntax.
+func formatIndexedContent(content string, _ []model.Parameter, values []string, _ model.SnippetFormatOptions) string {
+	return formatContent(content, values)
+}
+
 func formatContent(command string, values []string) string {
 	if len(values) == 0 {
 		return command
@@ -3,6 +3,7 @@ package pet
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
@@ -46,9 +47,11 @@ func Test_parseSnippetsFromTOML(t *testing.T) {
 	system := testutil.NewTestSystem()
 	contents := string(system.ReadFile(testDataSnippetFile))
 
-	snippets := parseSnippetsFromTOML(contents)
+	modified := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	snippets := parseSnippetsFromTOML(testDataSnippetFile, contents, modified)
 	assert.Len(t, snippets, 2)
-	assert.NotEmpty(t, snippets[0].GetID())
+	assert.Equal(t, model.SnippetMetadata{Manager: Key, Source: testDataSnippetFile, Modified: modified}, snippets[0].GetMetadata())
+	assert.Equal(t, snippetIDs(testDataSnippetFile, decodeSnippetsFile(contents).Snippets)[0], snippets[0].GetID())
 	assert.Equal(t, "Echo something", snippets[0].GetTitle())
 	assert.Equal(t,
 		"echo <VAR1> <VAR2=default_value> <VAR3=|_first value_||_second value here_||_third value can be = anything too _|>",
@@ -151,3 +154,20 @@ func Test_formatContent(t *testing.T) {
 		})
 	}
 }
+
+func Test_snippetIDs(t *testing.T) {
+	first := tomlSnippet{Description: "First", Command: "echo 1"}
+	second := tomlSnippet{Description: "Second", Command: "echo 2"}
+	third := tomlSnippet{Description: "Third", Command: "echo 3"}
+
+	ids := snippetIDs("snippet.toml", []tomlSnippet{first, second, third})
+	assert.Len(t, ids, 3)
+
+	// removing a snippet does not change the IDs of the following snippets
+	assert.Equal(t, []string{ids[0], ids[2]}, snippetIDs("snippet.toml", []tomlSnippet{first, third}))
+
+	// identical snippets have distinct IDs
+	duplicateIDs := snippetIDs("snippet.toml", []tomlSnippet{first, first})
+	assert.Equal(t, ids[0], duplicateIDs[0])
+	assert.NotEqual(t, duplicateIDs[0], duplicateIDs[1])
+}
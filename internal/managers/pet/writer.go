@@ -0,0 +1,83 @@
+package pet
+
+import (
+	"bytes"
+
+	"emperror.dev/errors"
+	"github.com/BurntSushi/toml"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+// CreateSnippet appends the snippet to the first configured snippet file.
+func (m Manager) CreateSnippet(draft model.SnippetDraft) string {
+	if len(m.config.LibraryPaths) == 0 {
+		panic(errors.New("no pet snippet file configured"))
+	}
+
+	path := m.config.LibraryPaths[0]
+	snippetsFile := tomlSnippetsFile{}
+	if m.system.FileExists(path) {
+		snippetsFile = decodeSnippetsFile(string(m.system.ReadFile(path)))
+	}
+
+	snippetsFile.Snippets = append(snippetsFile.Snippets, tomlSnippet{
+		Description: draft.Title,
+		Command:     draft.Content,
+		Tags:        draft.Tags,
+	})
+
+	m.writeSnippetsFile(path, snippetsFile)
+	ids := snippetIDs(path, snippetsFile.Snippets)
+	return ids[len(ids)-1]
+}
+
+func (m Manager) UpdateSnippet(id string, draft model.SnippetDraft) {
+	m.modifySnippetsFile(id, func(snippetsFile *tomlSnippetsFile, index int) {
+		snippetsFile.Snippets[index].Description = draft.Title
+		snippetsFile.Snippets[index].Command = draft.Content
+		snippetsFile.Snippets[index].Tags = draft.Tags
+	})
+}
+
+func (m Manager) DeleteSnippet(id string) {
+	m.modifySnippetsFile(id, func(snippetsFile *tomlSnippetsFile, index int) {
+		snippetsFile.Snippets = append(snippetsFile.Snippets[:index], snippetsFile.Snippets[index+1:]...)
+	})
+}
+
+func (m Manager) RenameSnippet(id string, title string) {
+	m.modifySnippetsFile(id, func(snippetsFile *tomlSnippetsFile, index int) {
+		snippetsFile.Snippets[index].Description = title
+	})
+}
+
+// modifySnippetsFile looks up the snippet file containing the snippet with the given ID, applies the modification and
+// rewrites the whole file. Comments and formatting of the original file are not preserved.
+func (m Manager) modifySnippetsFile(id string, modify func(snippetsFile *tomlSnippetsFile, index int)) {
+	for _, path := range m.config.LibraryPaths {
+		snippetsFile := decodeSnippetsFile(string(m.system.ReadFile(path)))
+		for i, snippetID := range snippetIDs(path, snippetsFile.Snippets) {
+			if snippetID == id {
+				modify(&snippetsFile, i)
+				m.writeSnippetsFile(path, snippetsFile)
+				return
+			}
+		}
+	}
+	panic(errors.Errorf("snippet not found: %s", id))
+}
+
+func (m Manager) writeSnippetsFile(path string, snippetsFile tomlSnippetsFile) {
+	var buffer bytes.Buffer
+	encoder := toml.NewEncoder(&buffer)
+	encoder.Indent = ""
+	if err := encoder.Encode(snippetsFile); err != nil {
+		panic(errors.Wrapf(err, "failed to encode pet snippet file %s", path))
+	}
+	m.system.WriteFile(path, buffer.Bytes())
+}
+
+func (m Manager) Writable() bool {
+	return len(m.config.LibraryPaths) > 0
+}
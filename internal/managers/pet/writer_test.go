@@ -0,0 +1,86 @@
+package pet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func newWriterTestManager(t *testing.T, paths ...string) (*Manager, *system.System) {
+	t.Helper()
+	sys := testutil.NewTestSystem()
+	manager, err := NewManager(WithSystem(sys), WithConfig(Config{Enabled: true, LibraryPaths: paths}))
+	assert.NoError(t, err)
+	return manager, sys
+}
+
+func Test_CreateSnippet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snippet.toml")
+	manager, sys := newWriterTestManager(t, path)
+
+	firstID := manager.CreateSnippet(model.SnippetDraft{Title: "First", Content: "echo <name>", Tags: []string{"foo"}})
+	secondID := manager.CreateSnippet(model.SnippetDraft{Title: "Second", Content: "ls -la"})
+
+	assert.Equal(t, `[[snippets]]
+description = "First"
+command = "echo <name>"
+tag = ["foo"]
+output = ""
+
+[[snippets]]
+description = "Second"
+command = "ls -la"
+output = ""
+`, string(sys.ReadFile(path)))
+
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 2)
+	assert.Equal(t, firstID, snippets[0].GetID())
+	assert.Equal(t, secondID, snippets[1].GetID())
+	assert.Equal(t, "First", snippets[0].GetTitle())
+	assert.Len(t, snippets[0].GetParameters(), 1)
+}
+
+func Test_CreateSnippet_noLibraryPath(t *testing.T) {
+	manager, _ := newWriterTestManager(t)
+	assert.False(t, manager.Writable())
+	assert.PanicsWithError(t, "no pet snippet file configured", func() {
+		manager.CreateSnippet(model.SnippetDraft{Title: "Foo"})
+	})
+}
+
+func Test_UpdateRenameDeleteSnippet(t *testing.T) {
+	sys := testutil.NewTestSystem()
+	path := filepath.Join(t.TempDir(), "snippet.toml")
+	sys.WriteFile(path, sys.ReadFile(testDataSnippetFile))
+
+	manager, err := NewManager(WithSystem(sys), WithConfig(Config{Enabled: true, LibraryPaths: []string{path}}))
+	assert.NoError(t, err)
+
+	ids := []string{manager.GetSnippets()[0].GetID(), manager.GetSnippets()[1].GetID()}
+
+	manager.UpdateSnippet(ids[1], model.SnippetDraft{
+		Title: "Watch pods", Content: "watch kubectl get pods", Tags: []string{"k8s"},
+	})
+	snippets := manager.GetSnippets()
+	assert.Equal(t, "Watch pods", snippets[1].GetTitle())
+	assert.Equal(t, "watch kubectl get pods", snippets[1].GetContent())
+	assert.Equal(t, []string{"k8s"}, snippets[1].GetTags())
+
+	manager.RenameSnippet(ids[0], "Echo")
+	assert.Equal(t, "Echo", manager.GetSnippets()[0].GetTitle())
+
+	manager.DeleteSnippet(manager.GetSnippets()[0].GetID())
+	snippets = manager.GetSnippets()
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "Watch pods", snippets[0].GetTitle())
+
+	assert.PanicsWithError(t, "snippet not found: unknown", func() {
+		manager.DeleteSnippet("unknown")
+	})
+}
@@ -2,6 +2,7 @@ package pictarinesnip
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/idutil"
@@ -14,14 +15,28 @@ var languageMapping = map[string]model.Language{
 	"shell":    model.LanguageBash,
 	"yaml":     model.LanguageYAML,
 	"markdown": model.LanguageMarkdown,
+
+	"python":     model.LanguagePython,
+	"ruby":       model.LanguageRuby,
+	"javascript": model.LanguageJavaScript,
+	"powershell": model.LanguagePowerShell,
+	"perl":       model.LanguagePerl,
+	"sql":        model.LanguageSQL,
+	"dockerfile": model.LanguageDockerfile,
+	"json":       model.LanguageJSON,
 }
 
+// appleReferenceDate is the reference date of the timestamps stored by Snip.
+var appleReferenceDate = time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+
 type picatrineSnippet struct {
-	ID      string   `json:"id"`
-	Name    string   `json:"name"`
-	Tags    []string `json:"tags"`
-	Snippet string   `json:"snippet"`
-	Mode    struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Tags           []string `json:"tags"`
+	Snippet        string   `json:"snippet"`
+	CreationDate   float64  `json:"creationDate"`
+	LastUpdateDate float64  `json:"lastUpdateDate"`
+	Mode           struct {
 		Name string `json:"name"`
 	} `json:"mode"`
 }
@@ -37,10 +52,10 @@ func parseLibrary(path string, system *system.System, tags *stringutil.StringSet
 		panic(err)
 	}
 
-	return mapToModel(snippets, tags)
+	return mapToModel(path, snippets, tags)
 }
 
-func mapToModel(rawSnippets []picatrineSnippet, tags *stringutil.StringSet) []model.Snippet {
+func mapToModel(path string, rawSnippets []picatrineSnippet, tags *stringutil.StringSet) []model.Snippet {
 	var result []model.Snippet
 
 	for i := range rawSnippets {
@@ -56,11 +71,26 @@ func mapToModel(rawSnippets []picatrineSnippet, tags *stringutil.StringSet) []mo
 			tags:     raw.Tags,
 			language: mapToLanguage(raw.Mode.Name),
 			content:  raw.Snippet,
+			metadata: model.SnippetMetadata{
+				Manager:  Key,
+				Source:   path,
+				Created:  toTime(raw.CreationDate),
+				Modified: toTime(raw.LastUpdateDate),
+				ReadOnly: true,
+			},
 		})
 	}
 	return result
 }
 
+// toTime converts the seconds since the Apple reference date. The zero time is returned if no timestamp is set.
+func toTime(seconds float64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return appleReferenceDate.Add(time.Duration(seconds * float64(time.Second)))
+}
+
 // https://github.com/Pictarine/macos-snippets/blob/aeb70a4b0e04025be9b511ea5810dd41671d89e7/Snip/Model/Mode.swift
 func mapToLanguage(name string) model.Language {
 	if entry, ok := languageMapping[name]; ok {
@@ -2,6 +2,7 @@ package pictarinesnip
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -24,6 +25,10 @@ func Test_parseLibrary(t *testing.T) {
 	assert.Equal(t, model.LanguageBash, snippet1.GetLanguage())
 	assert.Equal(t, []string{"snipkit"}, snippet1.GetTags())
 	assert.Len(t, snippet1.GetParameters(), 3)
+	assert.Equal(t, Key, snippet1.GetMetadata().Manager)
+	assert.Equal(t, testDataDefaultLibraryPath, snippet1.GetMetadata().Source)
+	assert.Equal(t, time.Date(2022, 1, 15, 13, 33, 36, 0, time.UTC), snippet1.GetMetadata().Created.Truncate(time.Second))
+	assert.True(t, snippet1.GetMetadata().ReadOnly)
 	assert.NotEqual(t, snippet1.GetContent(), snippet1.Format([]string{"one", "two", "three"}, model.SnippetFormatOptions{}))
 
 	snippet2 := snippets[1]
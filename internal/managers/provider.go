@@ -1,17 +1,29 @@
 package managers
 
 import (
+	"fmt"
+
 	"github.com/phuslu/log"
 
 	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/managers/cheat"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
 	"github.com/lemoony/snipkit/internal/managers/githubgist"
+	"github.com/lemoony/snipkit/internal/managers/gitlab"
+	"github.com/lemoony/snipkit/internal/managers/gitrepo"
 	"github.com/lemoony/snipkit/internal/managers/masscode"
+	"github.com/lemoony/snipkit/internal/managers/navi"
+	"github.com/lemoony/snipkit/internal/managers/notebook"
 	"github.com/lemoony/snipkit/internal/managers/pet"
 	"github.com/lemoony/snipkit/internal/managers/pictarinesnip"
+	"github.com/lemoony/snipkit/internal/managers/shellhistory"
 	"github.com/lemoony/snipkit/internal/managers/snippetslab"
+	"github.com/lemoony/snipkit/internal/managers/tasks"
+	"github.com/lemoony/snipkit/internal/managers/tldr"
+	"github.com/lemoony/snipkit/internal/managers/vscode"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/ui"
+	"github.com/lemoony/snipkit/internal/ui/uimsg"
 	"github.com/lemoony/snipkit/internal/utils/system"
 )
 
@@ -27,26 +39,35 @@ func NewBuilder() Provider {
 	return providerImpl{}
 }
 
+// CreateManager creates all enabled managers. If a manager cannot be created, a warning is printed and the manager is
+// skipped so that the snippets of all other managers are still available.
 func (p providerImpl) CreateManager(system system.System, cache cache.Cache, config Config, printer ui.MessagePrinter) []Manager {
-	var managers []Manager
-
-	if manager := createSnippetsLab(system, config); manager != nil {
-		managers = append(managers, manager)
-	}
-	if manager := createPictarineSnip(system, config); manager != nil {
-		managers = append(managers, manager)
-	}
-	if manager := createPetConfig(system, config); manager != nil {
-		managers = append(managers, manager)
-	}
-	if manager := createMassCodeConfig(system, config); manager != nil {
-		managers = append(managers, manager)
-	}
-	if manager := createGitHubGist(system, config, cache); manager != nil {
-		managers = append(managers, manager)
+	creators := []struct {
+		key    model.ManagerKey
+		create func() Manager
+	}{
+		{snippetslab.Key, func() Manager { return createSnippetsLab(system, config, cache) }},
+		{pictarinesnip.Key, func() Manager { return createPictarineSnip(system, config) }},
+		{pet.Key, func() Manager { return createPetConfig(system, config, cache) }},
+		{masscode.Key, func() Manager { return createMassCodeConfig(system, config, cache) }},
+		{githubgist.Key, func() Manager { return createGitHubGist(system, config, cache) }},
+		{fslibrary.Key, func() Manager { return createFSLibrary(system, config, cache, printer) }},
+		{gitrepo.Key, func() Manager { return createGitRepository(system, config) }},
+		{gitlab.Key, func() Manager { return createGitLab(system, config, cache) }},
+		{navi.Key, func() Manager { return createNavi(system, config) }},
+		{tldr.Key, func() Manager { return createTldr(system, config) }},
+		{shellhistory.Key, func() Manager { return createShellHistory(system, config) }},
+		{cheat.Key, func() Manager { return createCheat(system, config) }},
+		{vscode.Key, func() Manager { return createVSCode(system, config) }},
+		{notebook.Key, func() Manager { return createMarkdownNotebook(system, config) }},
+		{tasks.Key, func() Manager { return createProjectTasks(system, config) }},
 	}
-	if manager := createFSLibrary(system, config, printer); manager != nil {
-		managers = append(managers, manager)
+
+	var managers []Manager
+	for _, creator := range creators {
+		if manager := createManager(creator.key, printer, creator.create); manager != nil {
+			managers = append(managers, manager)
+		}
 	}
 
 	log.Info().Msgf("Number of enabled managers: %d", len(managers))
@@ -54,6 +75,17 @@ func (p providerImpl) CreateManager(system system.System, cache cache.Cache, con
 	return managers
 }
 
+func createManager(key model.ManagerKey, printer ui.MessagePrinter, create func() Manager) (manager Manager) {
+	defer func() {
+		if panicValue := recover(); panicValue != nil {
+			log.Warn().Str("manager", string(key)).Msgf("Failed to create manager: %v", panicValue)
+			printer.Print(uimsg.ManagerLoadFailed(string(key), fmt.Sprint(panicValue)))
+			manager = nil
+		}
+	}()
+	return create()
+}
+
 func (p providerImpl) ManagerDescriptions(config Config) []model.ManagerDescription {
 	var infos []model.ManagerDescription
 	if config.SnippetsLab == nil || !config.SnippetsLab.Enabled {
@@ -74,6 +106,33 @@ func (p providerImpl) ManagerDescriptions(config Config) []model.ManagerDescript
 	if config.FsLibrary == nil || !config.FsLibrary.Enabled {
 		infos = append(infos, fslibrary.Description(config.FsLibrary))
 	}
+	if config.GitRepository == nil || !config.GitRepository.Enabled {
+		infos = append(infos, gitrepo.Description(config.GitRepository))
+	}
+	if config.GitLab == nil || !config.GitLab.Enabled {
+		infos = append(infos, gitlab.Description(config.GitLab))
+	}
+	if config.Navi == nil || !config.Navi.Enabled {
+		infos = append(infos, navi.Description(config.Navi))
+	}
+	if config.Tldr == nil || !config.Tldr.Enabled {
+		infos = append(infos, tldr.Description(config.Tldr))
+	}
+	if config.ShellHistory == nil || !config.ShellHistory.Enabled {
+		infos = append(infos, shellhistory.Description(config.ShellHistory))
+	}
+	if config.Cheat == nil || !config.Cheat.Enabled {
+		infos = append(infos, cheat.Description(config.Cheat))
+	}
+	if config.VSCode == nil || !config.VSCode.Enabled {
+		infos = append(infos, vscode.Description(config.VSCode))
+	}
+	if config.MarkdownNotebook == nil || !config.MarkdownNotebook.Enabled {
+		infos = append(infos, notebook.Description(config.MarkdownNotebook))
+	}
+	if config.ProjectTasks == nil || !config.ProjectTasks.Enabled {
+		infos = append(infos, tasks.Description(config.ProjectTasks))
+	}
 	return infos
 }
 
@@ -91,17 +150,36 @@ func (p providerImpl) AutoConfig(key model.ManagerKey, s *system.System) Config
 		return Config{GithubGist: githubgist.AutoDiscoveryConfig()}
 	case fslibrary.Key:
 		return Config{FsLibrary: fslibrary.AutoDiscoveryConfig(s)}
+	case gitrepo.Key:
+		return Config{GitRepository: gitrepo.AutoDiscoveryConfig(s)}
+	case gitlab.Key:
+		return Config{GitLab: gitlab.AutoDiscoveryConfig()}
+	case navi.Key:
+		return Config{Navi: navi.AutoDiscoveryConfig(s)}
+	case tldr.Key:
+		return Config{Tldr: tldr.AutoDiscoveryConfig(s)}
+	case shellhistory.Key:
+		return Config{ShellHistory: shellhistory.AutoDiscoveryConfig(s)}
+	case cheat.Key:
+		return Config{Cheat: cheat.AutoDiscoveryConfig(s)}
+	case vscode.Key:
+		return Config{VSCode: vscode.AutoDiscoveryConfig(s)}
+	case notebook.Key:
+		return Config{MarkdownNotebook: notebook.AutoDiscoveryConfig()}
+	case tasks.Key:
+		return Config{ProjectTasks: tasks.AutoDiscoveryConfig()}
 	}
 	return Config{}
 }
 
-func createSnippetsLab(system system.System, config Config) Manager {
+func createSnippetsLab(system system.System, config Config, cache cache.Cache) Manager {
 	if config.SnippetsLab == nil || !config.SnippetsLab.Enabled {
 		return nil
 	}
 	manager, err := snippetslab.NewManager(
 		snippetslab.WithSystem(&system),
 		snippetslab.WithConfig(*config.SnippetsLab),
+		snippetslab.WithCache(cache),
 	)
 	if err != nil {
 		panic(err)
@@ -123,22 +201,22 @@ func createPictarineSnip(system system.System, config Config) Manager {
 	return manager
 }
 
-func createPetConfig(system system.System, config Config) Manager {
+func createPetConfig(system system.System, config Config, cache cache.Cache) Manager {
 	if config.Pet == nil || !config.Pet.Enabled {
 		return nil
 	}
-	manager, err := pet.NewManager(pet.WithSystem(&system), pet.WithConfig(*config.Pet))
+	manager, err := pet.NewManager(pet.WithSystem(&system), pet.WithConfig(*config.Pet), pet.WithCache(cache))
 	if err != nil {
 		panic(err)
 	}
 	return manager
 }
 
-func createMassCodeConfig(system system.System, config Config) Manager {
+func createMassCodeConfig(system system.System, config Config, cache cache.Cache) Manager {
 	if config.MassCode == nil || !config.MassCode.Enabled {
 		return nil
 	}
-	manager, err := masscode.NewManager(masscode.WithSystem(&system), masscode.WithConfig(*config.MassCode))
+	manager, err := masscode.NewManager(masscode.WithSystem(&system), masscode.WithConfig(*config.MassCode), masscode.WithCache(cache))
 	if err != nil {
 		panic(err)
 	}
@@ -160,13 +238,14 @@ func createGitHubGist(system system.System, config Config, cache cache.Cache) Ma
 	return manager
 }
 
-func createFSLibrary(system system.System, config Config, printer ui.MessagePrinter) Manager {
+func createFSLibrary(system system.System, config Config, cache cache.Cache, printer ui.MessagePrinter) Manager {
 	if config.FsLibrary == nil || !config.FsLibrary.Enabled {
 		return nil
 	}
 	manager, err := fslibrary.NewManager(
 		fslibrary.WithSystem(&system),
 		fslibrary.WithConfig(*config.FsLibrary),
+		fslibrary.WithCache(cache),
 		fslibrary.WithPrinter(printer),
 	)
 	if err != nil {
@@ -174,3 +253,130 @@ func createFSLibrary(system system.System, config Config, printer ui.MessagePrin
 	}
 	return manager
 }
+
+func createGitRepository(system system.System, config Config) Manager {
+	if config.GitRepository == nil || !config.GitRepository.Enabled {
+		return nil
+	}
+	manager, err := gitrepo.NewManager(
+		gitrepo.WithSystem(&system),
+		gitrepo.WithConfig(*config.GitRepository),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createGitLab(system system.System, config Config, cache cache.Cache) Manager {
+	if config.GitLab == nil || !config.GitLab.Enabled {
+		return nil
+	}
+	manager, err := gitlab.NewManager(
+		gitlab.WithSystem(&system),
+		gitlab.WithConfig(*config.GitLab),
+		gitlab.WithCache(cache),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createNavi(system system.System, config Config) Manager {
+	if config.Navi == nil || !config.Navi.Enabled {
+		return nil
+	}
+	manager, err := navi.NewManager(
+		navi.WithSystem(&system),
+		navi.WithConfig(*config.Navi),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createTldr(system system.System, config Config) Manager {
+	if config.Tldr == nil || !config.Tldr.Enabled {
+		return nil
+	}
+	manager, err := tldr.NewManager(
+		tldr.WithSystem(&system),
+		tldr.WithConfig(*config.Tldr),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createShellHistory(system system.System, config Config) Manager {
+	if config.ShellHistory == nil || !config.ShellHistory.Enabled {
+		return nil
+	}
+	manager, err := shellhistory.NewManager(
+		shellhistory.WithSystem(&system),
+		shellhistory.WithConfig(*config.ShellHistory),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createCheat(system system.System, config Config) Manager {
+	if config.Cheat == nil || !config.Cheat.Enabled {
+		return nil
+	}
+	manager, err := cheat.NewManager(
+		cheat.WithSystem(&system),
+		cheat.WithConfig(*config.Cheat),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createVSCode(system system.System, config Config) Manager {
+	if config.VSCode == nil || !config.VSCode.Enabled {
+		return nil
+	}
+	manager, err := vscode.NewManager(
+		vscode.WithSystem(&system),
+		vscode.WithConfig(*config.VSCode),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createMarkdownNotebook(system system.System, config Config) Manager {
+	if config.MarkdownNotebook == nil || !config.MarkdownNotebook.Enabled {
+		return nil
+	}
+	manager, err := notebook.NewManager(
+		notebook.WithSystem(&system),
+		notebook.WithConfig(*config.MarkdownNotebook),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+func createProjectTasks(system system.System, config Config) Manager {
+	if config.ProjectTasks == nil || !config.ProjectTasks.Enabled {
+		return nil
+	}
+	manager, err := tasks.NewManager(
+		tasks.WithSystem(&system),
+		tasks.WithConfig(*config.ProjectTasks),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
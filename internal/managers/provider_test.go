@@ -4,15 +4,26 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/managers/cheat"
 	"github.com/lemoony/snipkit/internal/managers/fslibrary"
 	"github.com/lemoony/snipkit/internal/managers/githubgist"
+	"github.com/lemoony/snipkit/internal/managers/gitlab"
+	"github.com/lemoony/snipkit/internal/managers/gitrepo"
 	"github.com/lemoony/snipkit/internal/managers/masscode"
+	"github.com/lemoony/snipkit/internal/managers/navi"
+	"github.com/lemoony/snipkit/internal/managers/notebook"
 	"github.com/lemoony/snipkit/internal/managers/pet"
 	"github.com/lemoony/snipkit/internal/managers/pictarinesnip"
+	"github.com/lemoony/snipkit/internal/managers/shellhistory"
 	"github.com/lemoony/snipkit/internal/managers/snippetslab"
+	"github.com/lemoony/snipkit/internal/managers/tasks"
+	"github.com/lemoony/snipkit/internal/managers/tldr"
+	"github.com/lemoony/snipkit/internal/managers/vscode"
 	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/ui/uimsg"
 	"github.com/lemoony/snipkit/internal/utils/testutil"
 	mocks "github.com/lemoony/snipkit/mocks/ui"
 )
@@ -75,6 +86,24 @@ func Test_AutoConfig(t *testing.T) {
 				assert.NotNil(t, config.GithubGist)
 			case fslibrary.Key:
 				assert.NotNil(t, config.FsLibrary)
+			case gitrepo.Key:
+				assert.NotNil(t, config.GitRepository)
+			case gitlab.Key:
+				assert.NotNil(t, config.GitLab)
+			case navi.Key:
+				assert.NotNil(t, config.Navi)
+			case tldr.Key:
+				assert.NotNil(t, config.Tldr)
+			case shellhistory.Key:
+				assert.NotNil(t, config.ShellHistory)
+			case cheat.Key:
+				assert.NotNil(t, config.Cheat)
+			case vscode.Key:
+				assert.NotNil(t, config.VSCode)
+			case notebook.Key:
+				assert.NotNil(t, config.MarkdownNotebook)
+			case tasks.Key:
+				assert.NotNil(t, config.ProjectTasks)
 			}
 		})
 	}
@@ -136,5 +165,89 @@ func testConfigs() []struct {
 				}
 			},
 		},
+		{
+			key: gitrepo.Key,
+			configFunc: func(config *Config) {
+				config.GitRepository = &gitrepo.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: gitlab.Key,
+			configFunc: func(config *Config) {
+				config.GitLab = &gitlab.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: navi.Key,
+			configFunc: func(config *Config) {
+				config.Navi = &navi.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: tldr.Key,
+			configFunc: func(config *Config) {
+				config.Tldr = &tldr.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: shellhistory.Key,
+			configFunc: func(config *Config) {
+				config.ShellHistory = &shellhistory.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: cheat.Key,
+			configFunc: func(config *Config) {
+				config.Cheat = &cheat.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: vscode.Key,
+			configFunc: func(config *Config) {
+				config.VSCode = &vscode.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: notebook.Key,
+			configFunc: func(config *Config) {
+				config.MarkdownNotebook = &notebook.Config{
+					Enabled: true,
+				}
+			},
+		},
+		{
+			key: tasks.Key,
+			configFunc: func(config *Config) {
+				config.ProjectTasks = &tasks.Config{
+					Enabled: true,
+				}
+			},
+		},
 	}
 }
+
+func Test_createManager_failing(t *testing.T) {
+	printer := mocks.MessagePrinter{}
+	printer.On("Print", mock.Anything).Return()
+
+	manager := createManager(pet.Key, &printer, func() Manager {
+		panic("file not found")
+	})
+
+	assert.Nil(t, manager)
+	printer.AssertCalled(t, "Print", uimsg.ManagerLoadFailed(string(pet.Key), "file not found"))
+}
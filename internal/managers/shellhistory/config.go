@@ -0,0 +1,56 @@
+package shellhistory
+
+import (
+	"path/filepath"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+type Shell string
+
+const (
+	ShellBash = Shell("bash")
+	ShellZsh  = Shell("zsh")
+	ShellFish = Shell("fish")
+)
+
+type Config struct {
+	Enabled     bool           `yaml:"enabled" head_comment:"If set to false, the shell history will not be provided to you."`
+	Sources     []SourceConfig `yaml:"sources" head_comment:"List of history files to read."`
+	IgnoreRegex []string       `yaml:"ignoreRegex" head_comment:"Commands matching one of the listed regular expressions are ignored."`
+	MaxEntries  int            `yaml:"maxEntries" head_comment:"Maximum number of commands provided to you (the highest ranked ones). A value of 0 means no limit."`
+	MinLength   int            `yaml:"minLength" head_comment:"Commands with less characters than the given value are ignored."`
+}
+
+type SourceConfig struct {
+	Shell Shell  `yaml:"shell" head_comment:"Format of the history file. Supported values: bash, zsh (plain or extended history), fish."`
+	Path  string `yaml:"path" head_comment:"Path to the history file."`
+}
+
+func AutoDiscoveryConfig(system *system.System) *Config {
+	candidates := []SourceConfig{
+		{Shell: ShellBash, Path: filepath.Join(system.UserHome(), bashHistoryFile)},
+		{Shell: ShellZsh, Path: filepath.Join(system.UserHome(), zshHistoryFile)},
+		{Shell: ShellFish, Path: filepath.Join(system.UserDataHome(), fishHistoryFile)},
+	}
+
+	var sources []SourceConfig
+	for _, candidate := range candidates {
+		if system.FileExists(candidate.Path) {
+			sources = append(sources, candidate)
+		}
+	}
+
+	found := len(sources) > 0
+	if !found {
+		sources = candidates[:1]
+	}
+
+	return &Config{
+		Enabled:     found,
+		Sources:     sources,
+		IgnoreRegex: []string{defaultIgnoreRegex},
+		MaxEntries:  defaultMaxEntries,
+		MinLength:   defaultMinLength,
+	}
+}
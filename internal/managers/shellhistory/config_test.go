@@ -0,0 +1,37 @@
+package shellhistory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	s := testutil.NewTestSystem(
+		system.WithUserHome("testdata/userhome"),
+		system.WithUserDataDir("testdata/userhome/.local/share"),
+	)
+
+	cfg := AutoDiscoveryConfig(s)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, []SourceConfig{
+		{Shell: ShellBash, Path: "testdata/userhome/.bash_history"},
+		{Shell: ShellFish, Path: "testdata/userhome/.local/share/fish/fish_history"},
+	}, cfg.Sources)
+	assert.Equal(t, defaultMaxEntries, cfg.MaxEntries)
+	assert.Equal(t, defaultMinLength, cfg.MinLength)
+}
+
+func Test_AutoDiscoveryConfig_notFound(t *testing.T) {
+	s := testutil.NewTestSystem(
+		system.WithUserHome("testdata/not-found-dir"),
+		system.WithUserDataDir("testdata/not-found-dir"),
+	)
+
+	cfg := AutoDiscoveryConfig(s)
+	assert.False(t, cfg.Enabled)
+	assert.Len(t, cfg.Sources, 1)
+}
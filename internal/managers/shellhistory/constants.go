@@ -0,0 +1,19 @@
+package shellhistory
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	bashHistoryFile = ".bash_history"
+	zshHistoryFile  = ".zsh_history"
+	fishHistoryFile = "fish/fish_history"
+
+	defaultIgnoreRegex = `^(ls|ll|cd|pwd|clear|exit|history)(\s|$)`
+	defaultMaxEntries  = 500
+	defaultMinLength   = 5
+
+	// recencyWindow defines how fast the rank of a command decreases the longer it has not been used. A command which
+	// was last used recencyWindow distinct commands ago has half the weight of the most recent one.
+	recencyWindow = 100
+
+	idPrefix idutil.IDPrefix = "history"
+)
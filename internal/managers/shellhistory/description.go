@@ -0,0 +1,14 @@
+package shellhistory
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("shellHistory")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "Shell History",
+		Description: "Use the commands of your bash, zsh or fish history ranked by frequency and recency",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
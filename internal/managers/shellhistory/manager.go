@@ -0,0 +1,162 @@
+package shellhistory
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+type Manager struct {
+	system      *system.System
+	config      Config
+	ignoreRegex []*regexp.Regexp
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+
+	for _, expr := range manager.config.IgnoreRegex {
+		r, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid ignore regex: %s", expr)
+		}
+		manager.ignoreRegex = append(manager.ignoreRegex, r)
+	}
+
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Shell history enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	for _, source := range m.config.Sources {
+		lines = append(lines, model.InfoLine{
+			IsError: !m.system.FileExists(source.Path),
+			Key:     fmt.Sprintf("Shell history file (%s)", source.Shell),
+			Value:   source.Path,
+		})
+	}
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "Shell history total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var entries []historyEntry
+
+	for _, source := range m.config.Sources {
+		if !m.system.FileExists(source.Path) {
+			log.Warn().Str("path", source.Path).Msg("shell history file does not exist")
+			continue
+		}
+		for _, entry := range parseHistory(source.Shell, m.system.ReadFile(source.Path)) {
+			if m.isValidCommand(entry.command) {
+				entry.source = source.Path
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	ranked := rankCommands(entries)
+	if m.config.MaxEntries > 0 && len(ranked) > m.config.MaxEntries {
+		ranked = ranked[:m.config.MaxEntries]
+	}
+
+	result := make([]model.Snippet, len(ranked))
+	for i, command := range ranked {
+		result[i] = &snippetImpl{
+			id:       idutil.FormatSnippetID(command.command, idPrefix),
+			title:    strings.SplitN(command.command, "\n", 2)[0],
+			content:  command.command,
+			tags:     []string{string(command.shell)},
+			language: model.LanguageBash,
+			metadata: model.SnippetMetadata{
+				Manager:  Key,
+				Source:   command.source,
+				Created:  unixTime(command.firstUsed),
+				Modified: unixTime(command.lastUsed),
+				ReadOnly: true,
+			},
+		}
+	}
+
+	return result
+}
+
+func unixTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+func (m *Manager) isValidCommand(command string) bool {
+	command = strings.TrimSpace(command)
+	if command == "" || utf8.RuneCountInString(command) < m.config.MinLength {
+		return false
+	}
+	for _, r := range m.ignoreRegex {
+		if r.MatchString(command) {
+			return false
+		}
+	}
+	return true
+}
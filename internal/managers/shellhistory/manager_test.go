@@ -0,0 +1,136 @@
+package shellhistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+var testSources = []SourceConfig{
+	{Shell: ShellBash, Path: "testdata/bash_history"},
+	{Shell: ShellZsh, Path: "testdata/zsh_history"},
+	{Shell: ShellFish, Path: "testdata/fish_history"},
+}
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{Enabled: true, Sources: testSources[:1]}
+
+	manager, err := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "Shell history enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "Shell history file (bash)", info[1].Key)
+	assert.Equal(t, "testdata/bash_history", info[1].Value)
+	assert.False(t, info[1].IsError)
+
+	assert.Equal(t, "Shell history total number of snippets", info[2].Key)
+	assert.Equal(t, "4", info[2].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_NewManager_invalidIgnoreRegex(t *testing.T) {
+	_, err := NewManager(WithConfig(Config{IgnoreRegex: []string{"("}}))
+	assert.Error(t, err)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name        string
+		ignoreRegex []string
+		maxEntries  int
+		minLength   int
+		expected    []string
+	}{
+		{
+			name: "all",
+			expected: []string{
+				"git status", "make build", "echo \"a\\nb\"", "kubectl get pods", "echo voilà", "ls",
+				"for i in 1 2; do\n  echo $i\ndone", "docker ps -a",
+			},
+		},
+		{name: "max entries", maxEntries: 2, expected: []string{"git status", "make build"}},
+		{
+			name: "ignore and min length", ignoreRegex: []string{"^git ", "^echo"}, minLength: 3,
+			expected: []string{"make build", "kubectl get pods", "for i in 1 2; do\n  echo $i\ndone", "docker ps -a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{
+				Enabled:     true,
+				Sources:     testSources,
+				IgnoreRegex: tt.ignoreRegex,
+				MaxEntries:  tt.maxEntries,
+				MinLength:   tt.minLength,
+			}
+			manager, err := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+			assert.NoError(t, err)
+
+			snippets := manager.GetSnippets()
+			contents := make([]string, len(snippets))
+			for i := range snippets {
+				contents[i] = snippets[i].GetContent()
+				assert.Equal(t, model.LanguageBash, snippets[i].GetLanguage())
+			}
+			assert.Equal(t, tt.expected, contents)
+		})
+	}
+}
+
+func Test_GetSnippets_multiLineTitle(t *testing.T) {
+	config := Config{Enabled: true, Sources: testSources[1:2]}
+	manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+	for _, snippet := range manager.GetSnippets() {
+		if snippet.GetTitle() == "for i in 1 2; do" {
+			assert.Equal(t, []string{"zsh"}, snippet.GetTags())
+			return
+		}
+	}
+	assert.Fail(t, "multi-line command not found")
+}
+
+func Test_GetSnippets_metadata(t *testing.T) {
+	config := Config{Enabled: true, Sources: testSources[1:2]}
+	manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+	for _, snippet := range manager.GetSnippets() {
+		if snippet.GetContent() == "make build" {
+			assert.Equal(t, model.SnippetMetadata{
+				Manager:  Key,
+				Source:   "testdata/zsh_history",
+				Created:  time.Unix(1700000050, 0),
+				Modified: time.Unix(1700000250, 0),
+				ReadOnly: true,
+			}, snippet.GetMetadata())
+			return
+		}
+	}
+	assert.Fail(t, "command not found")
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
@@ -0,0 +1,208 @@
+package shellhistory
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	zshMeta = 0x83
+
+	fishCommandPrefix = "- cmd: "
+	fishWhenPrefix    = "when: "
+)
+
+var (
+	bashTimestampRegex = regexp.MustCompile(`^#(\d+)$`)
+	zshExtendedRegex   = regexp.MustCompile(`^: (\d+):\d+;(.*)$`)
+)
+
+type historyEntry struct {
+	command string
+	shell   Shell
+	when    int64
+	// source is the path of the history file.
+	source string
+}
+
+type rankedCommand struct {
+	command  string
+	shell    Shell
+	source   string
+	count    int
+	position int
+	// firstUsed and lastUsed are unix timestamps. They are 0 if the history file does not hold timestamps.
+	firstUsed int64
+	lastUsed  int64
+}
+
+func (c rankedCommand) score() float64 {
+	return float64(c.count) / (1 + float64(c.position)/recencyWindow)
+}
+
+func parseHistory(shell Shell, contents []byte) []historyEntry {
+	var entries []historyEntry
+	switch shell {
+	case ShellZsh:
+		entries = parseZshHistory(contents)
+	case ShellFish:
+		entries = parseFishHistory(string(contents))
+	default:
+		entries = parseBashHistory(string(contents))
+	}
+
+	// Entries without timestamp inherit the one of the previous entry so that entries of different history files
+	// can be merged in chronological order.
+	var last int64
+	for i := range entries {
+		entries[i].shell = shell
+		entries[i].command = strings.TrimSpace(entries[i].command)
+		if entries[i].when == 0 {
+			entries[i].when = last
+		} else {
+			last = entries[i].when
+		}
+	}
+
+	return entries
+}
+
+// parseBashHistory reads a bash history file. Timestamps are available if HISTTIMEFORMAT was set, in which case each
+// command is preceded by a comment line holding the timestamp.
+func parseBashHistory(contents string) []historyEntry {
+	var result []historyEntry
+	var when int64
+
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if match := bashTimestampRegex.FindStringSubmatch(line); match != nil {
+			when, _ = strconv.ParseInt(match[1], 10, 64)
+			continue
+		}
+		if strings.TrimSpace(line) != "" {
+			result = append(result, historyEntry{command: line, when: when})
+		}
+		when = 0
+	}
+
+	return result
+}
+
+// parseZshHistory reads a zsh history file in plain or extended format. Lines of multi-line commands end with a
+// backslash.
+func parseZshHistory(contents []byte) []historyEntry {
+	var result []historyEntry
+	continued := false
+
+	for _, line := range strings.Split(unmetafy(contents), "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		if continued && len(result) > 0 {
+			last := &result[len(result)-1]
+			last.command += "\n" + line
+		} else if match := zshExtendedRegex.FindStringSubmatch(line); match != nil {
+			when, _ := strconv.ParseInt(match[1], 10, 64)
+			result = append(result, historyEntry{command: match[2], when: when})
+		} else if strings.TrimSpace(line) != "" {
+			result = append(result, historyEntry{command: line})
+		}
+
+		continued = strings.HasSuffix(line, "\\")
+		if continued && len(result) > 0 {
+			last := &result[len(result)-1]
+			last.command = strings.TrimSuffix(last.command, "\\")
+		}
+	}
+
+	return result
+}
+
+// unmetafy reverts the encoding zsh applies to special bytes in its history file.
+func unmetafy(contents []byte) string {
+	result := make([]byte, 0, len(contents))
+	for i := 0; i < len(contents); i++ {
+		if contents[i] == zshMeta && i+1 < len(contents) {
+			i++
+			result = append(result, contents[i]^32)
+		} else {
+			result = append(result, contents[i])
+		}
+	}
+	return string(result)
+}
+
+// parseFishHistory reads the YAML-like history file of fish. The file is not parsed as YAML since fish does not quote
+// commands, which would render the file invalid for commands containing special characters.
+func parseFishHistory(contents string) []historyEntry {
+	var result []historyEntry
+
+	for _, line := range strings.Split(contents, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, fishCommandPrefix):
+			result = append(result, historyEntry{command: unescapeFish(strings.TrimPrefix(line, fishCommandPrefix))})
+		case strings.HasPrefix(trimmed, fishWhenPrefix) && len(result) > 0:
+			result[len(result)-1].when, _ = strconv.ParseInt(strings.TrimPrefix(trimmed, fishWhenPrefix), 10, 64)
+		}
+	}
+
+	return result
+}
+
+func unescapeFish(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			default:
+				sb.WriteByte(s[i])
+			}
+			continue
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// rankCommands merges the given entries and ranks each distinct command by the number of executions weighted by how
+// recently it was used. The position of a command refers to the number of distinct commands used since.
+func rankCommands(entries []historyEntry) []rankedCommand {
+	sorted := make([]historyEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].when < sorted[j].when
+	})
+
+	var result []rankedCommand
+	indexByCommand := map[string]int{}
+
+	for i := len(sorted) - 1; i >= 0; i-- {
+		entry := sorted[i]
+		if index, ok := indexByCommand[entry.command]; ok {
+			result[index].count++
+			result[index].firstUsed = entry.when
+			continue
+		}
+		indexByCommand[entry.command] = len(result)
+		result = append(result, rankedCommand{
+			command:   entry.command,
+			shell:     entry.shell,
+			source:    entry.source,
+			count:     1,
+			position:  len(result),
+			firstUsed: entry.when,
+			lastUsed:  entry.when,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].score() > result[j].score()
+	})
+
+	return result
+}
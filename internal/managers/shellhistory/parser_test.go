@@ -0,0 +1,71 @@
+package shellhistory
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseHistory_bash(t *testing.T) {
+	entries := parseHistory(ShellBash, readTestdata(t, "testdata/bash_history"))
+	assert.Equal(t, []historyEntry{
+		{command: "git status", shell: ShellBash, when: 1700000000},
+		{command: "docker ps -a", shell: ShellBash, when: 1700000100},
+		{command: "git status", shell: ShellBash, when: 1700000200},
+		{command: "ls", shell: ShellBash, when: 1700000200},
+		{command: "kubectl get pods", shell: ShellBash, when: 1700000300},
+	}, entries)
+}
+
+func Test_parseHistory_zsh(t *testing.T) {
+	entries := parseHistory(ShellZsh, readTestdata(t, "testdata/zsh_history"))
+	assert.Equal(t, []historyEntry{
+		{command: "make build", shell: ShellZsh, when: 1700000050},
+		{command: "for i in 1 2; do\n  echo $i\ndone", shell: ShellZsh, when: 1700000150},
+		{command: "make build", shell: ShellZsh, when: 1700000250},
+		{command: "echo voilà", shell: ShellZsh, when: 1700000260},
+	}, entries)
+}
+
+func Test_parseHistory_zshPlain(t *testing.T) {
+	entries := parseHistory(ShellZsh, []byte("echo foo\necho bar\n"))
+	assert.Equal(t, []historyEntry{
+		{command: "echo foo", shell: ShellZsh},
+		{command: "echo bar", shell: ShellZsh},
+	}, entries)
+}
+
+func Test_parseHistory_fish(t *testing.T) {
+	entries := parseHistory(ShellFish, readTestdata(t, "testdata/fish_history"))
+	assert.Equal(t, []historyEntry{
+		{command: "git status", shell: ShellFish, when: 1700000400},
+		{command: "echo \"a\\nb\"", shell: ShellFish, when: 1700000500},
+	}, entries)
+}
+
+func Test_rankCommands(t *testing.T) {
+	entries := []historyEntry{
+		{command: "a", when: 1},
+		{command: "b", when: 2},
+		{command: "a", when: 3},
+		{command: "c", when: 4},
+	}
+
+	ranked := rankCommands(entries)
+	assert.Len(t, ranked, 3)
+
+	assert.Equal(t, "a", ranked[0].command)
+	assert.Equal(t, 2, ranked[0].count)
+	assert.Equal(t, 1, ranked[0].position)
+
+	assert.Equal(t, "c", ranked[1].command)
+	assert.Equal(t, "b", ranked[2].command)
+}
+
+func readTestdata(t *testing.T, path string) []byte {
+	t.Helper()
+	contents, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return contents
+}
@@ -6,6 +6,8 @@ import (
 
 	"emperror.dev/errors"
 
+	"github.com/lemoony/snipkit/internal/cache"
+	"github.com/lemoony/snipkit/internal/index"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/stringutil"
 	"github.com/lemoony/snipkit/internal/utils/system"
@@ -14,6 +16,7 @@ import (
 
 type Manager struct {
 	system *system.System
+	cache  cache.Cache
 	config Config
 }
 
@@ -42,6 +45,13 @@ func WithConfig(config Config) Option {
 	})
 }
 
+// WithCache sets the cache which holds the index of parsed snippets.
+func WithCache(cache cache.Cache) Option {
+	return optionFunc(func(p *Manager) {
+		p.cache = cache
+	})
+}
+
 func NewManager(options ...Option) (*Manager, error) {
 	manager := &Manager{}
 	for _, o := range options {
@@ -97,10 +107,12 @@ func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, cont
 func (m *Manager) GetSnippets() []model.Snippet {
 	validTagUUIDs, hasTags := m.getValidTagUUIDs()
 
-	snippets, err := parseSnippets(m.libraryPath())
+	snippetIndex := index.Load(m.cache, Key, m.config)
+	snippets, err := parseSnippets(m.libraryPath(), snippetIndex)
 	if err != nil {
 		panic(err)
 	}
+	snippetIndex.Save()
 
 	if hasTags {
 		var result []model.Snippet
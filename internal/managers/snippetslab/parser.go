@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/spf13/afero"
 	"howett.net/plist"
 
+	"github.com/lemoony/snipkit/internal/index"
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/utils/idutil"
 )
@@ -22,6 +25,16 @@ var languageMapping = map[string]model.Language{
 	"MarkdownLexer": model.LanguageMarkdown,
 	"TOMLLexer":     model.LanguageTOML,
 	"TextLexer":     model.LanguageText,
+
+	"PythonLexer":     model.LanguagePython,
+	"Python3Lexer":    model.LanguagePython,
+	"RubyLexer":       model.LanguageRuby,
+	"JavascriptLexer": model.LanguageJavaScript,
+	"PowerShellLexer": model.LanguagePowerShell,
+	"PerlLexer":       model.LanguagePerl,
+	"SqlLexer":        model.LanguageSQL,
+	"DockerLexer":     model.LanguageDockerfile,
+	"JsonLexer":       model.LanguageJSON,
 }
 
 //nolint:forcetypeassert // since we will catch any panic error and checking each statement explicitly is too much work
@@ -88,7 +101,9 @@ func parseTags(library snippetsLabLibrary) (map[string]string, error) {
 	return result, nil
 }
 
-func parseSnippets(library snippetsLabLibrary) ([]model.Snippet, error) {
+// parseSnippets parses all snippet files of the library. Snippet files which have not been modified since they were
+// indexed are not parsed again.
+func parseSnippets(library snippetsLabLibrary, snippetIndex *index.Index) ([]model.Snippet, error) {
 	filePath, err := library.snippetsFilePath()
 	if err != nil {
 		return []model.Snippet{}, err
@@ -100,13 +115,20 @@ func parseSnippets(library snippetsLabLibrary) ([]model.Snippet, error) {
 	// Call Readdir to get all files.
 	dirFiles, _ := dirRead.Readdir(0)
 
+	// the snippet files are read via the os package directly
+	osFs := afero.NewOsFs()
+
 	var snippets []model.Snippet
 	for i := range dirFiles {
-		file := dirFiles[i]
+		snippetPath := fmt.Sprintf("%s/%s", filePath, dirFiles[i].Name())
+		fingerprint := index.FileFingerprint(osFs, snippetPath)
 
-		if snippet, err2 := parseSnippet(fmt.Sprintf("%s/%s", filePath, file.Name())); err2 != nil {
+		if indexed, ok := snippetIndex.Lookup(snippetPath, fingerprint); ok {
+			snippets = append(snippets, indexed...)
+		} else if snippet, err2 := parseSnippet(snippetPath, dirFiles[i].ModTime()); err2 != nil {
 			return snippets, err2
 		} else {
+			snippetIndex.Put(snippetPath, fingerprint, []model.Snippet{snippet})
 			snippets = append(snippets, snippet)
 		}
 	}
@@ -115,7 +137,7 @@ func parseSnippets(library snippetsLabLibrary) ([]model.Snippet, error) {
 }
 
 //nolint:forcetypeassert,funlen // since we will catch any panic error and checking each statement explicitly is too much work
-func parseSnippet(path string) (model.Snippet, error) {
+func parseSnippet(path string, modified time.Time) (model.Snippet, error) {
 	fileBytes, err := os.ReadFile(filepath.Clean(path))
 	if err != nil {
 		return snippetImpl{}, err
@@ -175,6 +197,7 @@ func parseSnippet(path string) (model.Snippet, error) {
 		tags:     tagUUIDS,
 		content:  string(partMap0ContentData),
 		title:    objects[titleIndex].(string),
+		metadata: model.SnippetMetadata{Manager: Key, Source: path, Modified: modified, ReadOnly: true},
 	}
 
 	return snippet, nil
@@ -22,7 +22,7 @@ func Test_parseTags(t *testing.T) {
 func Test_parseSnippets(t *testing.T) {
 	library := snippetsLabLibrary(testDataDefaultLibraryPath)
 
-	snippets, err := parseSnippets(library)
+	snippets, err := parseSnippets(library, nil)
 	assert.NoError(t, err)
 	assert.Len(t, snippets, 2)
 
@@ -38,6 +38,9 @@ func Test_parseSnippets(t *testing.T) {
 	assert.NotEqual(t, snippet1.Format([]string{"one", "two"}, model.SnippetFormatOptions{}), snippet1.GetContent())
 	then.AssertThat(t, snippet1.GetContent(), is.MatchForPattern("^# some comment.*"))
 	then.AssertThat(t, snippet1.GetTitle(), is.AnyOf(is.EqualTo("Simple echo")))
+	assert.Equal(t, Key, snippet1.GetMetadata().Manager)
+	assert.FileExists(t, snippet1.GetMetadata().Source)
+	assert.True(t, snippet1.GetMetadata().ReadOnly)
 
 	snippet2 := snippets[1]
 	assert.Equal(t, idutil.FormatSnippetID("B3EDC3BE-6FE1-489E-9EB8-C400D4CF1B54", idPrefix), snippet2.GetID())
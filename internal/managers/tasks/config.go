@@ -0,0 +1,23 @@
+package tasks
+
+type Config struct {
+	Enabled     bool     `yaml:"enabled" head_comment:"If set to false, the tasks of Makefiles, justfiles and package.json files will not be provided to you."`
+	Paths       []string `yaml:"paths" head_comment:"List of additional project directories whose tasks should always be provided. Tasks of the current working directory are always included."`
+	MaxDepth    int      `yaml:"maxDepth" head_comment:"Maximum depth of subdirectories which are searched for task files (0 means only the directory itself)."`
+	Makefile    bool     `yaml:"makefile" head_comment:"If set to true, the targets of Makefiles are provided as snippets."`
+	Justfile    bool     `yaml:"justfile" head_comment:"If set to true, the recipes of justfiles are provided as snippets."`
+	PackageJSON bool     `yaml:"packageJson" head_comment:"If set to true, the scripts of package.json files are provided as snippets."`
+	IncludeTags []string `yaml:"includeTags" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you. Each task is tagged with its kind (make, just, npm) and the name of its directory."`
+}
+
+func AutoDiscoveryConfig() *Config {
+	return &Config{
+		Enabled:     true,
+		Paths:       []string{},
+		MaxDepth:    defaultMaxDepth,
+		Makefile:    true,
+		Justfile:    true,
+		PackageJSON: true,
+		IncludeTags: []string{},
+	}
+}
@@ -0,0 +1,18 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	config := AutoDiscoveryConfig()
+	assert.True(t, config.Enabled)
+	assert.Equal(t, defaultMaxDepth, config.MaxDepth)
+	assert.True(t, config.Makefile)
+	assert.True(t, config.Justfile)
+	assert.True(t, config.PackageJSON)
+	assert.Empty(t, config.Paths)
+	assert.Empty(t, config.IncludeTags)
+}
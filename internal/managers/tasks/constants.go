@@ -0,0 +1,22 @@
+package tasks
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	defaultMaxDepth = 2
+
+	tagMake = "make"
+	tagJust = "just"
+	tagNpm  = "npm"
+
+	gitDir = ".git"
+
+	idPrefix idutil.IDPrefix = "task"
+)
+
+var (
+	makefileNames    = []string{"GNUmakefile", "makefile", "Makefile"}
+	justfileNames    = []string{"justfile", "Justfile", ".justfile"}
+	packageJSONName  = "package.json"
+	ignoredDirectory = map[string]struct{}{"node_modules": {}, "vendor": {}}
+)
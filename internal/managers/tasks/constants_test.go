@@ -0,0 +1,9 @@
+package tasks
+
+const (
+	testDataProjectDir  = "testdata/project"
+	testDataOtherDir    = "testdata/other"
+	testDataMakefile    = "testdata/project/Makefile"
+	testDataJustfile    = "testdata/project/justfile"
+	testDataPackageJSON = "testdata/project/web/package.json"
+)
@@ -0,0 +1,14 @@
+package tasks
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("projectTasks")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "Project tasks",
+		Description: "Use the targets of Makefiles, the recipes of justfiles and the scripts of package.json files of the current project",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
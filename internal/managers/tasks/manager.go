@@ -0,0 +1,330 @@
+package tasks
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Project tasks enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "Project tasks paths",
+		Value:   strings.Join(m.config.Paths, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "Project tasks total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+
+	workingDir := m.system.WorkingDir()
+	if workingDir != "" {
+		workingDir = m.absPath(workingDir)
+	}
+	for _, dir := range m.taskDirs(workingDir) {
+		for _, snippet := range m.dirSnippets(dir, workingDir) {
+			if tagutil.HasValidTag(validTags, snippet.GetTags()) {
+				result = append(result, snippet)
+			}
+		}
+	}
+
+	return result
+}
+
+// IsPrioritized returns true if the task belongs to the current working directory, i.e., it is defined in the working
+// directory, one of its subdirectories or one of its parent directories within the same project.
+func (m Manager) IsPrioritized(snippet model.Snippet) bool {
+	if s, ok := snippet.(*snippetImpl); ok {
+		return s.prioritized
+	}
+	return false
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+type taskDir struct {
+	path        string
+	prioritized bool
+}
+
+// taskDirs returns all directories which should be checked for task files. Directories related to the working
+// directory come first: the parent directories up to the root of the enclosing git repository, the working
+// directory itself and its subdirectories. They are followed by the configured paths.
+func (m *Manager) taskDirs(workingDir string) []taskDir {
+	var result []taskDir
+	seen := stringutil.StringSet{}
+
+	add := func(path string, prioritized bool) {
+		if !seen.Contains(path) {
+			seen.Add(path)
+			result = append(result, taskDir{path: path, prioritized: prioritized})
+		}
+	}
+
+	if workingDir != "" {
+		for _, dir := range m.projectParentDirs(workingDir) {
+			add(dir, true)
+		}
+		for _, dir := range m.subDirs(workingDir, m.config.MaxDepth) {
+			add(dir, true)
+		}
+	}
+
+	for _, path := range m.config.Paths {
+		root := m.absPath(path)
+		if !m.system.DirExists(root) {
+			log.Warn().Str("path", path).Msg("project tasks path does not exist")
+			continue
+		}
+		prioritized := workingDir != "" && isWithin(workingDir, root)
+		for _, dir := range m.subDirs(root, m.config.MaxDepth) {
+			add(dir, prioritized)
+		}
+	}
+
+	return result
+}
+
+// projectParentDirs returns the parent directories of the working directory up to the root of the enclosing git
+// repository (top-most first). If the working directory is not part of a git repository, no directories are returned.
+func (m *Manager) projectParentDirs(workingDir string) []string {
+	var parents []string
+	for dir := workingDir; ; {
+		if m.system.DirExists(filepath.Join(dir, gitDir)) {
+			for i, j := 0, len(parents)-1; i < j; i, j = i+1, j-1 {
+				parents[i], parents[j] = parents[j], parents[i]
+			}
+			return parents
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir || dir == m.system.UserHome() {
+			return nil
+		}
+		parents = append(parents, parent)
+		dir = parent
+	}
+}
+
+// subDirs returns the directory itself and all its subdirectories up to the given depth. Hidden directories and
+// dependency directories (e.g., node_modules) are ignored.
+func (m *Manager) subDirs(dir string, depth int) []string {
+	result := []string{dir}
+	if depth <= 0 {
+		return result
+	}
+
+	entries, err := afero.ReadDir(m.system.Fs, dir)
+	if err != nil {
+		log.Debug().Err(err).Str("dir", dir).Msg("failed to read directory")
+		return result
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if _, ok := ignoredDirectory[entry.Name()]; ok {
+			continue
+		}
+		result = append(result, m.subDirs(filepath.Join(dir, entry.Name()), depth-1)...)
+	}
+
+	return result
+}
+
+func (m *Manager) dirSnippets(dir taskDir, workingDir string) []model.Snippet {
+	var result []model.Snippet
+	location := locationLabel(dir.path, workingDir)
+
+	if m.config.Makefile {
+		if file, ok := m.findFile(dir.path, makefileNames); ok {
+			command := "make -C " + shellQuote(dir.path)
+			for _, t := range parseMakefile(string(m.system.ReadFile(file))) {
+				result = append(result, newSnippet(file, dir, location, taskKindMake, tagMake, command, t))
+			}
+		}
+	}
+
+	if m.config.Justfile {
+		if file, ok := m.findFile(dir.path, justfileNames); ok {
+			command := "just --justfile " + shellQuote(file)
+			for _, t := range parseJustfile(string(m.system.ReadFile(file))) {
+				result = append(result, newSnippet(file, dir, location, taskKindJust, tagJust, command, t))
+			}
+		}
+	}
+
+	if m.config.PackageJSON {
+		if file, ok := m.findFile(dir.path, []string{packageJSONName}); ok {
+			tasks, err := parsePackageJSON(m.system.ReadFile(file))
+			if err != nil {
+				log.Warn().Err(err).Str("path", file).Msg("failed to read package.json scripts")
+			}
+			command := m.packageRunner(dir.path)
+			for _, t := range tasks {
+				result = append(result, newSnippet(file, dir, location, taskKindNpm, tagNpm, command, t))
+			}
+		}
+	}
+
+	return result
+}
+
+func newSnippet(file string, dir taskDir, location string, kind taskKind, tag, command string, t task) *snippetImpl {
+	runner := command[:strings.Index(command, " ")]
+	title := fmt.Sprintf("%s %s", runner, t.name)
+	if kind == taskKindNpm {
+		title = fmt.Sprintf("%s run %s", runner, t.name)
+	}
+	if t.description != "" {
+		title = fmt.Sprintf("%s - %s", title, t.description)
+	}
+	if location != "" {
+		title = fmt.Sprintf("%s: %s", location, title)
+	}
+
+	args := shellQuote(t.name)
+	if kind == taskKindNpm {
+		args = "run " + args
+	}
+
+	return &snippetImpl{
+		id:          idutil.FormatSnippetID(fmt.Sprintf("%s#%s", file, t.name), idPrefix),
+		tags:        []string{tag, filepath.Base(dir.path)},
+		title:       title,
+		kind:        kind,
+		command:     fmt.Sprintf("%s %s", command, args),
+		parameters:  t.parameters,
+		variadic:    t.variadic,
+		prioritized: dir.prioritized,
+		metadata:    model.SnippetMetadata{Description: t.description, Manager: Key, Source: file, ReadOnly: true},
+	}
+}
+
+// packageRunner returns the command for running package.json scripts based on the lock file of the package manager.
+func (m *Manager) packageRunner(dir string) string {
+	switch {
+	case m.system.FileExists(filepath.Join(dir, "pnpm-lock.yaml")):
+		return "pnpm --dir " + shellQuote(dir)
+	case m.system.FileExists(filepath.Join(dir, "yarn.lock")):
+		return "yarn --cwd " + shellQuote(dir)
+	case m.system.FileExists(filepath.Join(dir, "bun.lockb")), m.system.FileExists(filepath.Join(dir, "bun.lock")):
+		return "bun --cwd " + shellQuote(dir)
+	default:
+		return "npm --prefix " + shellQuote(dir)
+	}
+}
+
+func (m *Manager) findFile(dir string, names []string) (string, bool) {
+	for _, name := range names {
+		if path := filepath.Join(dir, name); m.system.FileExists(path) {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func (m *Manager) absPath(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		path = filepath.Join(m.system.UserHome(), strings.TrimPrefix(path, "~"))
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// locationLabel returns a short label for the directory of a task relative to the working directory. It is empty
+// for tasks of the working directory itself.
+func locationLabel(dir, workingDir string) string {
+	if dir == workingDir {
+		return ""
+	}
+	if workingDir != "" && isWithin(dir, workingDir) {
+		if rel, err := filepath.Rel(workingDir, dir); err == nil {
+			return rel
+		}
+	}
+	return filepath.Base(dir)
+}
+
+// isWithin returns true if path equals root or is located within root.
+func isWithin(path, root string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
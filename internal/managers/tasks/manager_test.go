@@ -0,0 +1,171 @@
+package tasks
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{Enabled: true, Paths: []string{testDataOtherDir}, Justfile: true}
+
+	manager, err := NewManager(WithSystem(newTestSystem(t, absPath(t, testDataOtherDir))), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "Project tasks enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "Project tasks paths", info[1].Key)
+	assert.Equal(t, testDataOtherDir, info[1].Value)
+
+	assert.Equal(t, "Project tasks total number of snippets", info[2].Key)
+	assert.Equal(t, "1", info[2].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name        string
+		workingDir  string
+		config      Config
+		expectedLen int
+	}{
+		{name: "all", workingDir: testDataProjectDir, config: *AutoDiscoveryConfig(), expectedLen: 9},
+		{name: "max depth 0", workingDir: testDataProjectDir, config: Config{Makefile: true, Justfile: true, PackageJSON: true}, expectedLen: 6},
+		{name: "max depth 1", workingDir: testDataProjectDir, config: Config{Makefile: true, Justfile: true, PackageJSON: true, MaxDepth: 1}, expectedLen: 8},
+		{name: "makefile only", workingDir: testDataProjectDir, config: Config{Makefile: true, MaxDepth: 2}, expectedLen: 5},
+		{name: "include tags", workingDir: testDataProjectDir, config: Config{Makefile: true, Justfile: true, PackageJSON: true, MaxDepth: 2, IncludeTags: []string{"just", "npm"}}, expectedLen: 4},
+		{name: "additional path", workingDir: testDataProjectDir, config: Config{Justfile: true, Paths: []string{testDataOtherDir}}, expectedLen: 3},
+		{name: "not existing path", workingDir: testDataOtherDir, config: Config{Justfile: true, Paths: []string{"testdata/not-existing"}}, expectedLen: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.Enabled = true
+			sys := newTestSystem(t, absPath(t, tt.workingDir))
+			manager, err := NewManager(WithSystem(sys), WithConfig(tt.config))
+			assert.NoError(t, err)
+			assert.Len(t, manager.GetSnippets(), tt.expectedLen)
+		})
+	}
+}
+
+func Test_GetSnippets_contentAndTitle(t *testing.T) {
+	projectDir := absPath(t, testDataProjectDir)
+	sys := newTestSystem(t, projectDir)
+
+	manager, _ := NewManager(WithSystem(sys), WithConfig(*AutoDiscoveryConfig()))
+	snippets := manager.GetSnippets()
+	assert.Len(t, snippets, 9)
+
+	build := snippets[0]
+	assert.Equal(t, "make build - Build the binary", build.GetTitle())
+	assert.Equal(t, []string{tagMake, "project"}, build.GetTags())
+	assert.Equal(t, model.LanguageBash, build.GetLanguage())
+	assert.Equal(t, "Build the binary", build.GetMetadata().Description)
+	assert.Equal(t, filepath.Join(projectDir, "Makefile"), build.GetMetadata().Source)
+	assert.Equal(
+		t,
+		"make -C "+projectDir+` build GOFLAGS="${GOFLAGS}" VERSION="${VERSION}" COMMIT="${COMMIT}"`,
+		build.GetContent(),
+	)
+	assert.Equal(
+		t,
+		"make -C "+projectDir+" build VERSION=2.0.0 COMMIT='abc def'",
+		build.Format([]string{"-trimpath", "2.0.0", "abc def"}, model.SnippetFormatOptions{}),
+	)
+
+	deploy := snippets[4]
+	assert.Equal(t, "just deploy - Deploy the application", deploy.GetTitle())
+	assert.Equal(
+		t,
+		"just --justfile "+filepath.Join(projectDir, "justfile")+" deploy prod eu-west-1 api web",
+		deploy.Format([]string{"prod", "", "api web"}, model.SnippetFormatOptions{}),
+	)
+	assert.Equal(
+		t,
+		"just --justfile "+filepath.Join(projectDir, "justfile")+" deploy staging eu-west-1",
+		deploy.Format([]string{"", "", ""}, model.SnippetFormatOptions{}),
+	)
+
+	api := snippets[6]
+	assert.Equal(t, "services/api: make run", api.GetTitle())
+	assert.Equal(t, []string{tagMake, "api"}, api.GetTags())
+
+	build = snippets[7]
+	assert.Equal(t, "web: yarn run build - tsc -p .", build.GetTitle())
+	assert.Equal(t, "yarn --cwd "+filepath.Join(projectDir, "web")+" run build", build.Format(nil, model.SnippetFormatOptions{}))
+}
+
+func Test_IsPrioritized(t *testing.T) {
+	projectDir := absPath(t, testDataProjectDir)
+	config := Config{Enabled: true, Justfile: true, Paths: []string{testDataOtherDir}}
+
+	tests := []struct {
+		name                string
+		workingDir          string
+		gitRoot             bool
+		expectedPrioritized []bool
+	}{
+		{name: "working dir", workingDir: projectDir, expectedPrioritized: []bool{true, true, false}},
+		{name: "subdirectory without git", workingDir: filepath.Join(projectDir, "web"), expectedPrioritized: []bool{false}},
+		{name: "subdirectory of git repository", workingDir: filepath.Join(projectDir, "web"), gitRoot: true, expectedPrioritized: []bool{true, true, false}},
+		{name: "configured path", workingDir: absPath(t, testDataOtherDir), expectedPrioritized: []bool{true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sys := newTestSystem(t, tt.workingDir)
+			if tt.gitRoot {
+				assert.NoError(t, sys.Fs.MkdirAll(filepath.Join(projectDir, gitDir), 0o700))
+			}
+
+			manager, _ := NewManager(WithSystem(sys), WithConfig(config))
+			snippets := manager.GetSnippets()
+
+			var prioritized []bool
+			for _, snippet := range snippets {
+				prioritized = append(prioritized, manager.IsPrioritized(snippet))
+			}
+			assert.Equal(t, tt.expectedPrioritized, prioritized)
+		})
+	}
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
+
+// newTestSystem returns a test system using testdata as home directory, so that the search for the root of the
+// enclosing git repository does not leave the testdata directory.
+func newTestSystem(t *testing.T, workingDir string) *system.System {
+	t.Helper()
+	return testutil.NewTestSystem(system.WithWorkingDir(workingDir), system.WithUserHome(absPath(t, "testdata")))
+}
+
+func absPath(t *testing.T, path string) string {
+	t.Helper()
+	result, err := filepath.Abs(path)
+	assert.NoError(t, err)
+	return result
+}
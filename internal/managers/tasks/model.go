@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+type taskKind int
+
+const (
+	taskKindMake taskKind = iota
+	taskKindJust
+	taskKindNpm
+)
+
+type snippetImpl struct {
+	id         string
+	tags       []string
+	title      string
+	kind       taskKind
+	command    string
+	parameters []model.Parameter
+	// variadic holds the keys of just parameters accepting multiple arguments (+name or *name).
+	variadic map[string]bool
+	// prioritized is true if the task belongs to the current working directory.
+	prioritized bool
+	metadata    model.SnippetMetadata
+}
+
+var safeShellWordRegex = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+func (s snippetImpl) GetID() string {
+	return s.id
+}
+
+func (s snippetImpl) GetTitle() string {
+	return s.title
+}
+
+func (s snippetImpl) GetTags() []string {
+	return s.tags
+}
+
+// GetContent returns the command which runs the task. Parameters are shown as shell variables.
+func (s snippetImpl) GetContent() string {
+	placeholders := make([]string, len(s.parameters))
+	for i, p := range s.parameters {
+		placeholders[i] = "${" + p.Key + "}"
+	}
+	return s.format(placeholders, false)
+}
+
+func (s snippetImpl) GetLanguage() model.Language {
+	return model.LanguageBash
+}
+
+func (s snippetImpl) GetParameters() []model.Parameter {
+	return s.parameters
+}
+
+func (s snippetImpl) Format(values []string, _ model.SnippetFormatOptions) string {
+	return s.format(values, true)
+}
+
+func (s snippetImpl) format(values []string, quote bool) string {
+	args := []string{s.command}
+
+	q := func(value string) string {
+		if quote {
+			return shellQuote(value)
+		}
+		return `"` + value + `"`
+	}
+
+	switch s.kind {
+	case taskKindMake:
+		// Make variables are only overridden if the value differs from the default defined in the Makefile.
+		for i, p := range s.parameters {
+			if i < len(values) && values[i] != "" && values[i] != p.DefaultValue {
+				args = append(args, p.Key+"="+q(values[i]))
+			}
+		}
+	case taskKindJust:
+		// Recipe parameters are positional: optional parameters can only be omitted if all following ones are
+		// omitted as well.
+		for i, p := range s.parameters {
+			value := ""
+			if i < len(values) {
+				value = values[i]
+			}
+			if value == "" {
+				value = p.DefaultValue
+			}
+			if value == "" {
+				break
+			}
+			if s.variadic[p.Key] && quote {
+				for _, field := range strings.Fields(value) {
+					args = append(args, shellQuote(field))
+				}
+			} else {
+				args = append(args, q(value))
+			}
+		}
+	case taskKindNpm:
+		// package.json scripts do not have any parameters.
+	}
+
+	return strings.Join(args, " ")
+}
+
+// shellQuote quotes the given value so that it is passed to the shell as a single word.
+func shellQuote(value string) string {
+	if safeShellWordRegex.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func (s snippetImpl) GetMetadata() model.SnippetMetadata {
+	return s.metadata
+}
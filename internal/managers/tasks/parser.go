@@ -0,0 +1,378 @@
+package tasks
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+// task is a single runnable entry of a task file (a make target, a just recipe or a package.json script).
+type task struct {
+	name        string
+	description string
+	parameters  []model.Parameter
+	variadic    map[string]bool
+}
+
+type makeVariable struct {
+	value       string
+	description string
+}
+
+var (
+	makeAssignmentRegex = regexp.MustCompile(`^(?:(?:export|override)\s+)*([A-Za-z_][A-Za-z0-9_.-]*)\s*(?:\?=|:::=|::=|:=|\+=|!=|=)\s*(.*)$`)
+	makeRuleRegex       = regexp.MustCompile(`^([^\s:#=][^:#=]*?)\s*::?(?:\s*(.*))?$`)
+	makeReferenceRegex  = regexp.MustCompile(`\$[({]([A-Za-z_][A-Za-z0-9_]*)[)}]`)
+	makeDirectiveRegex  = regexp.MustCompile(`^(?:ifeq|ifneq|ifdef|ifndef|else|endif|include|-include|sinclude|vpath|unexport|undefine)\b`)
+)
+
+// parseMakefile returns the targets of a Makefile. Variables which are defined in the Makefile and referenced in
+// the recipe of a target are provided as parameters, so they can be overridden via make VAR=value.
+func parseMakefile(contents string) []task {
+	variables := map[string]makeVariable{}
+
+	type rawRule struct {
+		targets     []string
+		description string
+		recipe      []string
+	}
+	var rules []*rawRule
+	var currentRule *rawRule
+	var comments []string
+	inDefine := false
+
+	for _, line := range joinContinuationLines(contents) {
+		if inDefine {
+			if strings.HasPrefix(strings.TrimSpace(line), "endef") {
+				inDefine = false
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "\t") {
+			if currentRule != nil {
+				currentRule.recipe = append(currentRule.recipe, line)
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			comments = nil
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			comments = append(comments, strings.TrimSpace(strings.TrimLeft(trimmed, "#")))
+			continue
+		case strings.HasPrefix(trimmed, "define "):
+			inDefine = true
+			comments = nil
+			continue
+		case makeDirectiveRegex.MatchString(trimmed):
+			continue
+		}
+
+		if m := makeAssignmentRegex.FindStringSubmatch(trimmed); m != nil {
+			if _, ok := variables[m[1]]; !ok {
+				variables[m[1]] = makeVariable{value: stripMakeComment(m[2]), description: strings.Join(comments, " ")}
+			}
+			currentRule = nil
+			comments = nil
+			continue
+		}
+
+		currentRule = nil
+		if m := makeRuleRegex.FindStringSubmatch(trimmed); m != nil {
+			prerequisites, description, _ := strings.Cut(m[2], "##")
+			prerequisites, inlineRecipe, hasInlineRecipe := strings.Cut(prerequisites, ";")
+			if strings.Contains(prerequisites, "=") {
+				// target-specific variable assignment
+				comments = nil
+				continue
+			}
+
+			rule := &rawRule{targets: strings.Fields(m[1]), description: strings.TrimSpace(description)}
+			if rule.description == "" {
+				rule.description = strings.Join(comments, " ")
+			}
+			if hasInlineRecipe {
+				rule.recipe = append(rule.recipe, inlineRecipe)
+			}
+			rules = append(rules, rule)
+			currentRule = rule
+		}
+		comments = nil
+	}
+
+	var result []task
+	seen := map[string]struct{}{}
+	for _, rule := range rules {
+		parameters := makeParameters(rule.recipe, variables)
+		for _, target := range rule.targets {
+			if !isRunnableMakeTarget(target) {
+				continue
+			}
+			if _, ok := seen[target]; ok {
+				continue
+			}
+			seen[target] = struct{}{}
+			result = append(result, task{name: target, description: rule.description, parameters: parameters})
+		}
+	}
+	return result
+}
+
+func makeParameters(recipe []string, variables map[string]makeVariable) []model.Parameter {
+	var result []model.Parameter
+	seen := map[string]struct{}{}
+	for _, line := range recipe {
+		line = strings.ReplaceAll(line, "$$", "")
+		for _, m := range makeReferenceRegex.FindAllStringSubmatch(line, -1) {
+			variable, ok := variables[m[1]]
+			if !ok {
+				continue
+			}
+			if _, ok := seen[m[1]]; ok {
+				continue
+			}
+			seen[m[1]] = struct{}{}
+
+			param := model.Parameter{Key: m[1], Name: m[1], Description: variable.description}
+			if !strings.Contains(variable.value, "$") {
+				param.DefaultValue = variable.value
+			}
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+func isRunnableMakeTarget(target string) bool {
+	return !strings.HasPrefix(target, ".") && !strings.ContainsAny(target, "%$")
+}
+
+func stripMakeComment(value string) string {
+	if i := strings.Index(value, " #"); i >= 0 {
+		value = value[:i]
+	}
+	return strings.TrimSpace(value)
+}
+
+// joinContinuationLines splits the contents into lines, joining lines ending with a backslash.
+func joinContinuationLines(contents string) []string {
+	var result []string
+	var current strings.Builder
+	for _, line := range strings.Split(strings.ReplaceAll(contents, "\r\n", "\n"), "\n") {
+		if strings.HasSuffix(line, "\\") {
+			current.WriteString(strings.TrimSuffix(line, "\\"))
+			current.WriteString(" ")
+			continue
+		}
+		current.WriteString(line)
+		result = append(result, current.String())
+		current.Reset()
+	}
+	return result
+}
+
+var (
+	justRecipeNameRegex = regexp.MustCompile(`^@?([A-Za-z_][A-Za-z0-9_-]*)`)
+	justAssignmentRegex = regexp.MustCompile(`^(?:export\s+)?([A-Za-z_][A-Za-z0-9_-]*)\s*:=\s*(.*)$`)
+	justKeywordRegex    = regexp.MustCompile(`^(?:alias|set|import|mod|export)\s`)
+	justDocRegex        = regexp.MustCompile(`doc\(\s*(?:"([^"]*)"|'([^']*)')\s*\)`)
+)
+
+// parseJustfile returns the public recipes of a justfile. Recipe parameters are provided as parameters.
+func parseJustfile(contents string) []task {
+	variables := map[string]string{}
+	var result []task
+	var comments []string
+	var attributes []string
+
+	for _, line := range joinContinuationLines(contents) {
+		if line != "" && (line[0] == ' ' || line[0] == '\t') {
+			// recipe body
+			comments = nil
+			attributes = nil
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			comments = nil
+			attributes = nil
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			if !strings.HasPrefix(trimmed, "#!") {
+				comments = append(comments, strings.TrimSpace(strings.TrimLeft(trimmed, "#")))
+			}
+			continue
+		case strings.HasPrefix(trimmed, "["):
+			attributes = append(attributes, trimmed)
+			continue
+		}
+
+		if m := justAssignmentRegex.FindStringSubmatch(trimmed); m != nil {
+			if value, ok := justLiteral(strings.TrimSpace(m[2]), nil); ok {
+				variables[m[1]] = value
+			}
+			comments = nil
+			attributes = nil
+			continue
+		}
+
+		if justKeywordRegex.MatchString(trimmed) {
+			comments = nil
+			attributes = nil
+			continue
+		}
+
+		if t, ok := parseJustRecipeHeader(trimmed, variables); ok && !isPrivateJustRecipe(t.name, attributes) {
+			t.description = justDescription(comments, attributes)
+			result = append(result, t)
+		}
+		comments = nil
+		attributes = nil
+	}
+
+	return result
+}
+
+func parseJustRecipeHeader(line string, variables map[string]string) (task, bool) {
+	m := justRecipeNameRegex.FindStringSubmatch(line)
+	if m == nil {
+		return task{}, false
+	}
+
+	tokens, ok := tokenizeJustParameters(line[len(m[0]):])
+	if !ok {
+		return task{}, false
+	}
+
+	result := task{name: m[1], variadic: map[string]bool{}}
+	for _, token := range tokens {
+		token = strings.TrimPrefix(token, "$")
+		variadic := strings.HasPrefix(token, "+") || strings.HasPrefix(token, "*")
+		token = strings.TrimLeft(token, "+*")
+		token = strings.TrimPrefix(token, "$")
+
+		name, defaultValue, hasDefault := strings.Cut(token, "=")
+		param := model.Parameter{Key: name, Name: name}
+		if hasDefault {
+			if value, ok := justLiteral(defaultValue, variables); ok {
+				param.DefaultValue = value
+			}
+		}
+		if variadic {
+			result.variadic[name] = true
+			param.Description = "Accepts multiple values separated by whitespace"
+		}
+		result.parameters = append(result.parameters, param)
+	}
+
+	return result, true
+}
+
+// tokenizeJustParameters splits the parameter list of a recipe header. It returns false if the line is not a recipe
+// header, i.e., it does not contain a colon which is not part of a := assignment.
+func tokenizeJustParameters(value string) ([]string, bool) {
+	var tokens []string
+	var current strings.Builder
+	var quote rune
+	depth := 0
+
+	runes := []rune(value)
+	for i, r := range runes {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case depth == 0 && r == ':':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				return nil, false
+			}
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+			}
+			return tokens, true
+		case depth == 0 && (r == ' ' || r == '\t'):
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		current.WriteRune(r)
+	}
+
+	return nil, false
+}
+
+// justLiteral returns the value of a string literal or a reference to a variable with a known value. Expressions
+// and backticks cannot be evaluated and yield false.
+func justLiteral(value string, variables map[string]string) (string, bool) {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1], true
+	}
+	if v, ok := variables[value]; ok {
+		return v, true
+	}
+	return "", false
+}
+
+func isPrivateJustRecipe(name string, attributes []string) bool {
+	if strings.HasPrefix(name, "_") {
+		return true
+	}
+	for _, attribute := range attributes {
+		if strings.Contains(attribute, "private") {
+			return true
+		}
+	}
+	return false
+}
+
+func justDescription(comments, attributes []string) string {
+	for _, attribute := range attributes {
+		if m := justDocRegex.FindStringSubmatch(attribute); m != nil {
+			return m[1] + m[2]
+		}
+	}
+	return strings.Join(comments, " ")
+}
+
+// parsePackageJSON returns the scripts of a package.json file sorted by name. The script command is used as
+// description.
+func parsePackageJSON(contents []byte) ([]task, error) {
+	var packageFile struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if err := json.Unmarshal(contents, &packageFile); err != nil {
+		return nil, errors.Wrap(err, "failed to parse package.json")
+	}
+
+	names := make([]string, 0, len(packageFile.Scripts))
+	for name := range packageFile.Scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]task, len(names))
+	for i, name := range names {
+		result[i] = task{name: name, description: packageFile.Scripts[name]}
+	}
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_parseMakefile(t *testing.T) {
+	tasks := parseMakefile(string(testutil.NewTestSystem().ReadFile(testDataMakefile)))
+	assert.Len(t, tasks, 4)
+
+	assert.Equal(t, "build", tasks[0].name)
+	assert.Equal(t, "Build the binary", tasks[0].description)
+	assert.Equal(t, []model.Parameter{
+		{Key: "GOFLAGS", Name: "GOFLAGS", DefaultValue: "-trimpath"},
+		{Key: "VERSION", Name: "VERSION", Description: "Version of the binary", DefaultValue: "1.0.0"},
+		{Key: "COMMIT", Name: "COMMIT"},
+	}, tasks[0].parameters)
+
+	assert.Equal(t, "test", tasks[1].name)
+	assert.Equal(t, "Run all tests", tasks[1].description)
+	assert.Empty(t, tasks[1].parameters)
+
+	assert.Equal(t, "clean", tasks[2].name)
+	assert.Equal(t, "lint", tasks[3].name)
+}
+
+func Test_parseMakefile_continuationAndDefine(t *testing.T) {
+	contents := `NAME = app
+define HELP
+build: this is not a target
+endef
+
+ifeq ($(OS),Windows_NT)
+EXT = .exe
+endif
+
+build: \
+		deps
+	echo $(NAME)$(EXT) \
+		$(UNKNOWN)
+
+deps: VERSION = 1
+`
+	tasks := parseMakefile(contents)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, "build", tasks[0].name)
+	assert.Equal(t, []model.Parameter{
+		{Key: "NAME", Name: "NAME", DefaultValue: "app"},
+		{Key: "EXT", Name: "EXT", DefaultValue: ".exe"},
+	}, tasks[0].parameters)
+}
+
+func Test_parseJustfile(t *testing.T) {
+	tasks := parseJustfile(string(testutil.NewTestSystem().ReadFile(testDataJustfile)))
+	assert.Len(t, tasks, 2)
+
+	assert.Equal(t, "deploy", tasks[0].name)
+	assert.Equal(t, "Deploy the application", tasks[0].description)
+	assert.Equal(t, []model.Parameter{
+		{Key: "env", Name: "env", DefaultValue: "staging"},
+		{Key: "region", Name: "region", DefaultValue: "eu-west-1"},
+		{Key: "services", Name: "services", Description: "Accepts multiple values separated by whitespace"},
+	}, tasks[0].parameters)
+	assert.True(t, tasks[0].variadic["services"])
+
+	assert.Equal(t, "greet", tasks[1].name)
+	assert.Equal(t, "Print a greeting", tasks[1].description)
+	assert.Equal(t, []model.Parameter{
+		{Key: "name", Name: "name", DefaultValue: "World"},
+		{Key: "flags", Name: "flags", Description: "Accepts multiple values separated by whitespace"},
+	}, tasks[1].parameters)
+}
+
+func Test_tokenizeJustParameters(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+		ok       bool
+	}{
+		{name: "no parameters", value: ":", expected: nil, ok: true},
+		{name: "dependencies", value: ": build test", expected: nil, ok: true},
+		{name: "parameters", value: " a b='x y' +c:", expected: []string{"a", "b='x y'", "+c"}, ok: true},
+		{name: "colon in default", value: ` url="http://localhost:8080":`, expected: []string{`url="http://localhost:8080"`}, ok: true},
+		{name: "expression default", value: ` dir=(root / "x"):`, expected: []string{`dir=(root / "x")`}, ok: true},
+		{name: "assignment", value: ` := "value"`, expected: nil, ok: false},
+		{name: "no colon", value: ` foo bar`, expected: nil, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, ok := tokenizeJustParameters(tt.value)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, tokens)
+		})
+	}
+}
+
+func Test_parsePackageJSON(t *testing.T) {
+	tasks, err := parsePackageJSON(testutil.NewTestSystem().ReadFile(testDataPackageJSON))
+	assert.NoError(t, err)
+	assert.Equal(t, []task{
+		{name: "build", description: "tsc -p ."},
+		{name: "test", description: "vitest run"},
+	}, tasks)
+}
+
+func Test_parsePackageJSON_invalid(t *testing.T) {
+	tasks, err := parsePackageJSON([]byte("{"))
+	assert.Error(t, err)
+	assert.Nil(t, tasks)
+}
@@ -0,0 +1,52 @@
+package tldr
+
+import (
+	"path/filepath"
+	"runtime"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+type Config struct {
+	Enabled     bool     `yaml:"enabled" head_comment:"If set to false, tldr pages will not be provided to you."`
+	Path        string   `yaml:"path" head_comment:"Path to a local tldr pages checkout (the directory holding the pages directories) or to a tldr pages zip archive."`
+	Platforms   []string `yaml:"platforms" head_comment:"Platform subdirectories to consider, e.g. common, linux, osx, windows."`
+	Languages   []string `yaml:"languages" head_comment:"Languages to consider, e.g. en, de. The pages of language en are located in directory pages, all others in pages.<language>."`
+	IncludeTags []string `yaml:"includeTags" head_comment:"If this list is not empty, only the examples of those pages will be provided to you whose name matches one of the listed tags."`
+}
+
+func AutoDiscoveryConfig(system *system.System) *Config {
+	path, found := discoverPagesPath(system)
+	if !found {
+		path = "/path/to/tldr-pages"
+	}
+
+	return &Config{
+		Enabled:   found,
+		Path:      path,
+		Platforms: []string{platformCommon, currentPlatform()},
+		Languages: []string{defaultLanguage},
+	}
+}
+
+// discoverPagesPath checks the cache directories of common tldr clients for a local copy of tldr pages.
+func discoverPagesPath(system *system.System) (string, bool) {
+	for _, candidate := range knownClientCacheDirs {
+		path := filepath.Join(system.UserHome(), candidate)
+		if system.DirExists(filepath.Join(path, pagesDirName)) {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func currentPlatform() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "osx"
+	case "windows":
+		return "windows"
+	default:
+		return "linux"
+	}
+}
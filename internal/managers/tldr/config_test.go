@@ -0,0 +1,33 @@
+package tldr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		userHomeDir  string
+		enabled      bool
+		expectedPath string
+	}{
+		{name: "found", userHomeDir: "testdata/userhome", enabled: true, expectedPath: "testdata/userhome/.cache/tealdeer/tldr-pages"},
+		{name: "not found", userHomeDir: "testdata/not-found-dir", enabled: false, expectedPath: "/path/to/tldr-pages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testutil.NewTestSystem(system.WithUserHome(tt.userHomeDir))
+			cfg := AutoDiscoveryConfig(s)
+			assert.Equal(t, tt.enabled, cfg.Enabled)
+			assert.Equal(t, tt.expectedPath, cfg.Path)
+			assert.Equal(t, []string{platformCommon, currentPlatform()}, cfg.Platforms)
+			assert.Equal(t, []string{defaultLanguage}, cfg.Languages)
+		})
+	}
+}
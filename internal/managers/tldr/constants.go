@@ -0,0 +1,22 @@
+package tldr
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	pagesDirName    = "pages"
+	pageFileSuffix  = ".md"
+	platformCommon  = "common"
+	defaultLanguage = "en"
+	zipFileSuffix   = ".zip"
+
+	idPrefix idutil.IDPrefix = "tldr"
+)
+
+// knownClientCacheDirs lists the directories (relative to the user home) where popular tldr clients store their
+// local copy of tldr pages.
+var knownClientCacheDirs = []string{
+	".cache/tealdeer/tldr-pages",
+	".tldrc/tldr",
+	".tldr/cache",
+	".cache/tldr",
+}
@@ -0,0 +1,14 @@
+package tldr
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("tldr")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "tldr pages",
+		Description: "Use the examples of tldr pages from a local checkout or zip archive",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
@@ -0,0 +1,238 @@
+package tldr
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+}
+
+// page represents a single tldr page file.
+type page struct {
+	path     string
+	name     string
+	contents []byte
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "tldr enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: !m.system.FileExists(m.config.Path),
+		Key:     "tldr pages path",
+		Value:   m.config.Path,
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "tldr platforms",
+		Value:   strings.Join(m.config.Platforms, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "tldr languages",
+		Value:   strings.Join(m.config.Languages, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "tldr total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+
+	for _, p := range m.pages() {
+		if !tagutil.HasValidTag(validTags, []string{p.name}) {
+			continue
+		}
+		for _, snippet := range parsePage(p.path, p.name, string(p.contents)) {
+			result = append(result, snippet)
+		}
+	}
+
+	return result
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+func (m *Manager) pages() []page {
+	switch {
+	case m.config.Path == "" || !m.system.FileExists(m.config.Path):
+		log.Warn().Str("path", m.config.Path).Msg("tldr pages path does not exist")
+		return nil
+	case strings.HasSuffix(m.config.Path, zipFileSuffix):
+		return m.pagesFromZip()
+	default:
+		return m.pagesFromDir()
+	}
+}
+
+func (m *Manager) pagesFromDir() []page {
+	var result []page
+
+	for _, language := range m.config.Languages {
+		for _, platform := range m.config.Platforms {
+			dir := filepath.Join(m.config.Path, languageDirName(language), platform)
+			if !m.system.DirExists(dir) {
+				continue
+			}
+
+			entries, err := afero.ReadDir(m.system.Fs, dir)
+			if err != nil {
+				panic(err)
+			}
+
+			for _, entry := range entries {
+				if entry.IsDir() || filepath.Ext(entry.Name()) != pageFileSuffix {
+					continue
+				}
+				filePath := filepath.Join(dir, entry.Name())
+				result = append(result, page{
+					path:     filePath,
+					name:     strings.TrimSuffix(entry.Name(), pageFileSuffix),
+					contents: m.system.ReadFile(filePath),
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// pagesFromZip reads all pages from a zip archive. The archive may either hold the pages directories at its root
+// (like the archive published by tldr pages) or within a single top level directory (like a GitHub source archive).
+func (m *Manager) pagesFromZip() []page {
+	file, err := m.system.Fs.Open(m.config.Path)
+	if err != nil {
+		panic(system.NewErrFileSystem(err, m.config.Path, "failed to open tldr zip archive"))
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		panic(system.NewErrFileSystem(err, m.config.Path, "failed to stat tldr zip archive"))
+	}
+
+	reader, err := zip.NewReader(file, info.Size())
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to read tldr zip archive %s", m.config.Path))
+	}
+
+	languageDirs := stringutil.NewStringSet(nil)
+	for _, language := range m.config.Languages {
+		languageDirs.Add(languageDirName(language))
+	}
+	platforms := stringutil.NewStringSet(m.config.Platforms)
+
+	var result []page
+	for _, f := range reader.File {
+		parts := strings.Split(f.Name, "/")
+		if f.FileInfo().IsDir() || len(parts) < 3 || path.Ext(f.Name) != pageFileSuffix {
+			continue
+		}
+
+		if !languageDirs.Contains(parts[len(parts)-3]) || !platforms.Contains(parts[len(parts)-2]) {
+			continue
+		}
+
+		result = append(result, page{
+			path:     fmt.Sprintf("%s!%s", m.config.Path, f.Name),
+			name:     strings.TrimSuffix(parts[len(parts)-1], pageFileSuffix),
+			contents: readZipFile(f),
+		})
+	}
+
+	return result
+}
+
+func readZipFile(f *zip.File) []byte {
+	reader, err := f.Open()
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to open %s", f.Name))
+	}
+	defer func() { _ = reader.Close() }()
+
+	contents, err := io.ReadAll(reader)
+	if err != nil {
+		panic(errors.Wrapf(err, "failed to read %s", f.Name))
+	}
+	return contents
+}
+
+func languageDirName(language string) string {
+	if language == "" || language == defaultLanguage {
+		return pagesDirName
+	}
+	return fmt.Sprintf("%s.%s", pagesDirName, language)
+}
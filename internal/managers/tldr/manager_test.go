@@ -0,0 +1,131 @@
+package tldr
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+const testDataPagesDir = "testdata/tldr"
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{
+		Enabled:   true,
+		Path:      testDataPagesDir,
+		Platforms: []string{"common", "linux"},
+		Languages: []string{"en"},
+	}
+
+	manager, err := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 5)
+
+	assert.Equal(t, "tldr enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "tldr pages path", info[1].Key)
+	assert.Equal(t, testDataPagesDir, info[1].Value)
+	assert.False(t, info[1].IsError)
+
+	assert.Equal(t, "tldr platforms", info[2].Key)
+	assert.Equal(t, "common,linux", info[2].Value)
+
+	assert.Equal(t, "tldr languages", info[3].Key)
+	assert.Equal(t, "en", info[3].Value)
+
+	assert.Equal(t, "tldr total number of snippets", info[4].Key)
+	assert.Equal(t, "5", info[4].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	zipPath := createTestZip(t)
+
+	tests := []struct {
+		name                     string
+		path                     string
+		platforms                []string
+		languages                []string
+		includeTags              []string
+		expectedNumberOfSnippets int
+	}{
+		{name: "common", path: testDataPagesDir, platforms: []string{"common"}, languages: []string{"en"}, expectedNumberOfSnippets: 3},
+		{name: "common and linux", path: testDataPagesDir, platforms: []string{"common", "linux"}, languages: []string{"en"}, expectedNumberOfSnippets: 5},
+		{name: "language de", path: testDataPagesDir, platforms: []string{"common"}, languages: []string{"de"}, expectedNumberOfSnippets: 1},
+		{name: "include tag", path: testDataPagesDir, platforms: []string{"common", "linux", "osx"}, languages: []string{"en"}, includeTags: []string{"brew"}, expectedNumberOfSnippets: 1},
+		{name: "zip", path: zipPath, platforms: []string{"common", "linux"}, languages: []string{"en", "de"}, expectedNumberOfSnippets: 6},
+		{name: "not existing", path: "testdata/not-existing", platforms: []string{"common"}, languages: []string{"en"}, expectedNumberOfSnippets: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Enabled: true, Path: tt.path, Platforms: tt.platforms, Languages: tt.languages, IncludeTags: tt.includeTags}
+			manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+			assert.Len(t, manager.GetSnippets(), tt.expectedNumberOfSnippets)
+		})
+	}
+}
+
+func Test_Format(t *testing.T) {
+	config := Config{Enabled: true, Path: testDataPagesDir, Platforms: []string{"linux"}, Languages: []string{"en"}}
+	manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+	snippet := manager.GetSnippets()[1]
+	assert.Equal(t, "Install a package, or update it to the latest available version", snippet.GetTitle())
+	assert.Equal(t, "sudo apt install curl", snippet.Format([]string{"curl"}, model.SnippetFormatOptions{}))
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
+
+// createTestZip packs the test pages into a zip archive with a top level directory like a GitHub source archive.
+func createTestZip(t *testing.T) string {
+	t.Helper()
+
+	zipPath := filepath.Join(t.TempDir(), "tldr.zip")
+	zipFile, err := os.Create(zipPath)
+	assert.NoError(t, err)
+
+	writer := zip.NewWriter(zipFile)
+	assert.NoError(t, filepath.Walk(testDataPagesDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, _ := filepath.Rel(testDataPagesDir, path)
+		w, err := writer.Create("tldr-main/" + filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(contents)
+		return err
+	}))
+
+	assert.NoError(t, writer.Close())
+	assert.NoError(t, zipFile.Close())
+	return zipPath
+}
@@ -0,0 +1,46 @@
+package tldr
+
+import (
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+type snippetImpl struct {
+	id         string
+	tags       []string
+	title      string
+	content    string
+	parameters []model.Parameter
+	metadata   model.SnippetMetadata
+}
+
+func (s snippetImpl) GetID() string {
+	return s.id
+}
+
+func (s snippetImpl) GetTitle() string {
+	return s.title
+}
+
+func (s snippetImpl) GetTags() []string {
+	return s.tags
+}
+
+func (s snippetImpl) GetContent() string {
+	return s.content
+}
+
+func (s snippetImpl) GetLanguage() model.Language {
+	return model.LanguageBash
+}
+
+func (s snippetImpl) GetParameters() []model.Parameter {
+	return s.parameters
+}
+
+func (s snippetImpl) Format(values []string, _ model.SnippetFormatOptions) string {
+	return formatContent(s.content, s.parameters, values)
+}
+
+func (s snippetImpl) GetMetadata() model.SnippetMetadata {
+	return s.metadata
+}
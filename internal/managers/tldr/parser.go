@@ -0,0 +1,105 @@
+package tldr
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+const (
+	prefixExample     = "- "
+	prefixDescription = "> "
+	codeDelimiter     = "`"
+	pathPlaceholder   = "path/to/"
+	optionAlternative = "|"
+)
+
+var (
+	placeholderRegex       = regexp.MustCompile(`\{\{(.+?)\}\}`)
+	optionPlaceholderRegex = regexp.MustCompile(`^\[(.+)]$`)
+)
+
+// parsePage maps each example of a tldr page to a snippet. The name of the page is used as tag. The first description
+// line of the page is used as description of all snippets.
+func parsePage(path string, pageName string, contents string) []*snippetImpl {
+	var result []*snippetImpl
+	title := ""
+	description := ""
+
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, prefixDescription) && description == "":
+			description = strings.TrimSpace(strings.TrimPrefix(line, prefixDescription))
+		case strings.HasPrefix(line, prefixExample):
+			title = strings.TrimSuffix(strings.TrimSpace(strings.TrimPrefix(line, prefixExample)), ":")
+		case len(line) > 1 && strings.HasPrefix(line, codeDelimiter) && strings.HasSuffix(line, codeDelimiter):
+			command := strings.TrimSuffix(strings.TrimPrefix(line, codeDelimiter), codeDelimiter)
+			if title == "" {
+				title = command
+			}
+			result = append(result, &snippetImpl{
+				id:         idutil.FormatSnippetID(fmt.Sprintf("%s#%d", path, len(result)), idPrefix),
+				title:      title,
+				content:    command,
+				tags:       []string{pageName},
+				parameters: parseParameters(command),
+				metadata:   model.SnippetMetadata{Description: description, Manager: Key, Source: path, ReadOnly: true},
+			})
+			title = ""
+		}
+	}
+
+	return result
+}
+
+// parseParameters returns a parameter for each distinct {{placeholder}}. Placeholders referring to paths are
+// mapped to path parameters, option placeholders like {{[-r|--recursive]}} provide their alternatives as values.
+func parseParameters(command string) []model.Parameter {
+	var result []model.Parameter
+	visited := map[string]struct{}{}
+
+	for _, match := range placeholderRegex.FindAllStringSubmatch(command, -1) {
+		key := match[1]
+		if _, ok := visited[key]; ok {
+			continue
+		}
+		visited[key] = struct{}{}
+
+		parameter := model.Parameter{Key: key, Name: key}
+		if strings.Contains(key, pathPlaceholder) {
+			parameter.Type = model.ParameterTypePath
+		}
+		if option := optionPlaceholderRegex.FindStringSubmatch(key); option != nil && strings.Contains(option[1], optionAlternative) {
+			parameter.Values = strings.Split(option[1], optionAlternative)
+		}
+
+		result = append(result, parameter)
+	}
+
+	return result
+}
+
+func formatContent(content string, parameters []model.Parameter, values []string) string {
+	if len(values) == 0 {
+		return content
+	}
+
+	valuesByKey := map[string]string{}
+	for i := range parameters {
+		if i < len(values) {
+			valuesByKey[parameters[i].Key] = values[i]
+		}
+	}
+
+	return placeholderRegex.ReplaceAllStringFunc(content, func(match string) string {
+		if value, ok := valuesByKey[placeholderRegex.FindStringSubmatch(match)[1]]; ok {
+			return value
+		}
+		return match
+	})
+}
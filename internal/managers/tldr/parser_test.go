@@ -0,0 +1,56 @@
+package tldr
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const testDataTarPage = "testdata/tldr/pages/common/tar.md"
+
+func Test_parsePage(t *testing.T) {
+	contents, err := os.ReadFile(testDataTarPage)
+	assert.NoError(t, err)
+
+	snippets := parsePage(testDataTarPage, "tar", string(contents))
+	assert.Len(t, snippets, 3)
+
+	assert.Equal(t, "[c]reate an archive and write it to a [f]ile", snippets[0].GetTitle())
+	assert.Equal(t, "tar cf {{path/to/target.tar}} {{path/to/file1 path/to/file2 ...}}", snippets[0].GetContent())
+	assert.Equal(t, []string{"tar"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+	assert.Equal(t, []model.Parameter{
+		{Key: "path/to/target.tar", Name: "path/to/target.tar", Type: model.ParameterTypePath},
+		{Key: "path/to/file1 path/to/file2 ...", Name: "path/to/file1 path/to/file2 ...", Type: model.ParameterTypePath},
+	}, snippets[0].GetParameters())
+
+	assert.Equal(t, []model.Parameter{
+		{Key: "[-x|--extract]", Name: "[-x|--extract]", Values: []string{"-x", "--extract"}},
+		{Key: "path/to/source.tar[.gz|.bz2|.xz]", Name: "path/to/source.tar[.gz|.bz2|.xz]", Type: model.ParameterTypePath},
+	}, snippets[1].GetParameters())
+
+	assert.NotEqual(t, snippets[0].GetID(), snippets[1].GetID())
+	assert.Equal(t, model.SnippetMetadata{
+		Description: "Archiving utility.",
+		Manager:     Key,
+		Source:      testDataTarPage,
+		ReadOnly:    true,
+	}, snippets[0].GetMetadata())
+}
+
+func Test_parsePage_exampleWithoutDescription(t *testing.T) {
+	snippets := parsePage("dummy.md", "dummy", "# dummy\n\n`dummy {{arg}}`\n")
+	assert.Len(t, snippets, 1)
+	assert.Equal(t, "dummy {{arg}}", snippets[0].GetTitle())
+}
+
+func Test_formatContent(t *testing.T) {
+	content := "cp {{path/to/file}} {{path/to/target}} && ls {{path/to/target}}"
+	parameters := parseParameters(content)
+
+	assert.Equal(t, "cp a b && ls b", formatContent(content, parameters, []string{"a", "b"}))
+	assert.Equal(t, content, formatContent(content, parameters, nil))
+}
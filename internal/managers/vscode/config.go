@@ -0,0 +1,37 @@
+package vscode
+
+import (
+	"path/filepath"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+)
+
+type Config struct {
+	Enabled      bool     `yaml:"enabled" head_comment:"If set to false, VS Code snippets will not be provided to you."`
+	SnippetPaths []string `yaml:"snippetPaths" head_comment:"List of directories (searched recursively) or single files holding VS Code snippets (*.code-snippets or <language>.json)."`
+	Languages    []string `yaml:"languages" head_comment:"If this list is not empty, only snippets for the listed VS Code language identifiers (e.g., shellscript) are considered. Snippets without scope apply to all languages."`
+	IncludeTags  []string `yaml:"includeTags" head_comment:"If this list is not empty, only those snippets that match the listed tags will be provided to you. The prefixes of a snippet are used as tags."`
+}
+
+func AutoDiscoveryConfig(system *system.System) *Config {
+	var paths []string
+	for _, configDir := range system.UserConfigDirs() {
+		for _, appDir := range knownAppDirs {
+			path := filepath.Join(configDir, appDir, userSnippetsDir)
+			if system.DirExists(path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	found := len(paths) > 0
+	if !found {
+		paths = []string{"/path/to/Code/User/snippets"}
+	}
+
+	return &Config{
+		Enabled:      found,
+		SnippetPaths: paths,
+		Languages:    []string{languageIDShell},
+	}
+}
@@ -0,0 +1,32 @@
+package vscode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+func Test_AutoDiscoveryConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		configDirs    []string
+		enabled       bool
+		expectedPaths []string
+	}{
+		{name: "found", configDirs: []string{"testdata/not-found-dir", "testdata/config"}, enabled: true, expectedPaths: []string{testDataUserSnippets}},
+		{name: "not found", configDirs: []string{"testdata/not-found-dir"}, enabled: false, expectedPaths: []string{"/path/to/Code/User/snippets"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testutil.NewTestSystem(system.WithUserConfigDirs(tt.configDirs))
+			cfg := AutoDiscoveryConfig(s)
+			assert.Equal(t, tt.enabled, cfg.Enabled)
+			assert.Equal(t, tt.expectedPaths, cfg.SnippetPaths)
+			assert.Equal(t, []string{languageIDShell}, cfg.Languages)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package vscode
+
+import "github.com/lemoony/snipkit/internal/utils/idutil"
+
+const (
+	userSnippetsDir                    = "User/snippets"
+	codeSnippetsSuffix                 = ".code-snippets"
+	languageFileSuffix                 = ".json"
+	languageIDShell                    = "shellscript"
+	scopeSeparator                     = ","
+	idPrefix           idutil.IDPrefix = "vscode"
+)
+
+// knownAppDirs lists the names of the configuration directories of VS Code and its popular distributions.
+var knownAppDirs = []string{
+	"Code",
+	"Code - Insiders",
+	"VSCodium",
+}
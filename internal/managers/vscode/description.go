@@ -0,0 +1,14 @@
+package vscode
+
+import "github.com/lemoony/snipkit/internal/model"
+
+const Key = model.ManagerKey("vscode")
+
+func Description(config *Config) model.ManagerDescription {
+	return model.ManagerDescription{
+		Key:         Key,
+		Name:        "VS Code Snippets",
+		Description: "Use the user snippets of Visual Studio Code (*.code-snippets and language snippet files)",
+		Enabled:     config != nil && config.Enabled,
+	}
+}
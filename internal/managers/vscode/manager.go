@@ -0,0 +1,163 @@
+package vscode
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/phuslu/log"
+	"github.com/spf13/afero"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/stringutil"
+	"github.com/lemoony/snipkit/internal/utils/system"
+	"github.com/lemoony/snipkit/internal/utils/tagutil"
+)
+
+type Manager struct {
+	system *system.System
+	config Config
+}
+
+// Option configures a Manager.
+type Option interface {
+	apply(m *Manager)
+}
+
+// optionFunc wraps a func so that it satisfies the Option interface.
+type optionFunc func(m *Manager)
+
+func (f optionFunc) apply(m *Manager) {
+	f(m)
+}
+
+// WithSystem sets the utils.System instance to be used by Manager.
+func WithSystem(system *system.System) Option {
+	return optionFunc(func(m *Manager) {
+		m.system = system
+	})
+}
+
+func WithConfig(config Config) Option {
+	return optionFunc(func(m *Manager) {
+		m.config = config
+	})
+}
+
+func NewManager(options ...Option) (*Manager, error) {
+	manager := &Manager{}
+	for _, o := range options {
+		o.apply(manager)
+	}
+	return manager, nil
+}
+
+func (m Manager) Key() model.ManagerKey {
+	return Key
+}
+
+func (m *Manager) Sync(model.SyncEventChannel) {
+	// do nothing
+}
+
+func (m Manager) Info() []model.InfoLine {
+	var lines []model.InfoLine
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "VS Code enabled",
+		Value:   fmt.Sprintf("%v", m.config.Enabled),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false,
+		Key:     "VS Code snippet paths",
+		Value:   strings.Join(m.config.SnippetPaths, ","),
+	})
+
+	lines = append(lines, model.InfoLine{
+		IsError: false, Key: "VS Code total number of snippets", Value: fmt.Sprintf("%d", len(m.GetSnippets())),
+	})
+
+	return lines
+}
+
+func (m *Manager) GetSnippets() []model.Snippet {
+	var result []model.Snippet
+
+	validTags := stringutil.NewStringSet(m.config.IncludeTags)
+	validLanguages := stringutil.NewStringSet(m.config.Languages)
+
+	for _, snippetPath := range m.config.SnippetPaths {
+		for _, filePath := range m.snippetFiles(snippetPath) {
+			snippets, err := parseSnippetsFile(filePath, fileLanguageID(filePath), m.system.ReadFile(filePath))
+			if err != nil {
+				log.Warn().Err(err).Str("path", filePath).Msg("failed to parse VS Code snippets file")
+				continue
+			}
+
+			for _, snippet := range snippets {
+				if hasValidLanguage(validLanguages, snippet.languages) && tagutil.HasValidTag(validTags, snippet.GetTags()) {
+					result = append(result, snippet)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (m Manager) SaveAssistantSnippet(snippetTitle string, filename string, contents []byte) {
+	panic(errors.New("Not implemented"))
+}
+
+// snippetFiles returns all snippet files for the given path. If the path points to a directory, it is searched
+// recursively.
+func (m *Manager) snippetFiles(path string) []string {
+	if !m.system.DirExists(path) {
+		if !m.system.FileExists(path) {
+			log.Warn().Str("path", path).Msg("VS Code snippet path does not exist")
+			return nil
+		}
+		return []string{path}
+	}
+
+	var result []string
+	entries, err := afero.ReadDir(m.system.Fs, path)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+		if entry.IsDir() {
+			result = append(result, m.snippetFiles(entryPath)...)
+		} else if ext := filepath.Ext(entry.Name()); ext == codeSnippetsSuffix || ext == languageFileSuffix {
+			result = append(result, entryPath)
+		}
+	}
+
+	return result
+}
+
+// fileLanguageID returns the language identifier of a language snippets file (e.g., shellscript for
+// shellscript.json) or an empty string for global snippet files.
+func fileLanguageID(path string) string {
+	if filepath.Ext(path) == languageFileSuffix {
+		return strings.TrimSuffix(filepath.Base(path), languageFileSuffix)
+	}
+	return ""
+}
+
+func hasValidLanguage(validLanguages stringutil.StringSet, languageIDs []string) bool {
+	if len(validLanguages) == 0 || len(languageIDs) == 0 {
+		return true
+	}
+	for _, id := range languageIDs {
+		if validLanguages.Contains(id) {
+			return true
+		}
+	}
+	return false
+}
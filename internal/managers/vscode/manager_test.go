@@ -0,0 +1,90 @@
+package vscode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
+)
+
+const testDataProjectSnippets = "testdata/project/.vscode"
+
+func Test_GetInfo(t *testing.T) {
+	config := Config{Enabled: true, SnippetPaths: []string{testDataUserSnippets}}
+
+	manager, err := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+	assert.NoError(t, err)
+
+	info := manager.Info()
+	assert.Len(t, info, 3)
+
+	assert.Equal(t, "VS Code enabled", info[0].Key)
+	assert.Equal(t, "true", info[0].Value)
+
+	assert.Equal(t, "VS Code snippet paths", info[1].Key)
+	assert.Equal(t, testDataUserSnippets, info[1].Value)
+
+	assert.Equal(t, "VS Code total number of snippets", info[2].Key)
+	assert.Equal(t, "3", info[2].Value)
+}
+
+func Test_Key(t *testing.T) {
+	assert.Equal(t, Key, Manager{}.Key())
+}
+
+func Test_Sync(t *testing.T) {
+	events := make(model.SyncEventChannel)
+	manager := Manager{}
+	manager.Sync(events)
+	close(events)
+}
+
+func Test_GetSnippets(t *testing.T) {
+	tests := []struct {
+		name           string
+		paths          []string
+		languages      []string
+		includeTags    []string
+		expectedTitles []string
+	}{
+		{name: "all", paths: []string{testDataUserSnippets}, expectedTitles: []string{"Docker run", "Say hello", "Pod"}},
+		{name: "language filter", paths: []string{testDataUserSnippets}, languages: []string{"shellscript"}, expectedTitles: []string{"Docker run", "Say hello"}},
+		{name: "scope filter", paths: []string{testDataProjectSnippets}, languages: []string{"shellscript"}, expectedTitles: []string{"Build", "Everywhere"}},
+		{name: "tag filter", paths: []string{testDataUserSnippets, testDataProjectSnippets}, includeTags: []string{"docker", "build"}, expectedTitles: []string{"Docker run", "Build"}},
+		{name: "single file", paths: []string{testDataShellFile}, expectedTitles: []string{"Docker run", "Say hello"}},
+		{name: "not existing", paths: []string{"testdata/not-existing"}, expectedTitles: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Enabled: true, SnippetPaths: tt.paths, Languages: tt.languages, IncludeTags: tt.includeTags}
+			manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+			titles := []string{}
+			for _, snippet := range manager.GetSnippets() {
+				titles = append(titles, snippet.GetTitle())
+			}
+			assert.Equal(t, tt.expectedTitles, titles)
+		})
+	}
+}
+
+func Test_Format(t *testing.T) {
+	config := Config{Enabled: true, SnippetPaths: []string{testDataShellFile}}
+	manager, _ := NewManager(WithSystem(testutil.NewTestSystem()), WithConfig(config))
+
+	snippet := manager.GetSnippets()[0]
+	assert.Equal(
+		t,
+		"docker run -p 9000:90 httpd $HOME\necho 9000 ${HOME}",
+		snippet.Format([]string{"9000", "90", "httpd"}, model.SnippetFormatOptions{}),
+	)
+}
+
+func Test_SaveAssistantSnippet(t *testing.T) {
+	assert.PanicsWithError(t, "Not implemented", func() {
+		Manager{}.SaveAssistantSnippet("", "foo.sh", []byte("dummy content"))
+	})
+}
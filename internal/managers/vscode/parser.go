@@ -0,0 +1,393 @@
+package vscode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/idutil"
+)
+
+var languageMapping = map[string]model.Language{
+	"shellscript": model.LanguageBash,
+	"yaml":        model.LanguageYAML,
+	"markdown":    model.LanguageMarkdown,
+	"toml":        model.LanguageTOML,
+	"plaintext":   model.LanguageText,
+	"python":      model.LanguagePython,
+	"ruby":        model.LanguageRuby,
+	"javascript":  model.LanguageJavaScript,
+	"powershell":  model.LanguagePowerShell,
+	"perl":        model.LanguagePerl,
+	"sql":         model.LanguageSQL,
+	"dockerfile":  model.LanguageDockerfile,
+	"json":        model.LanguageJSON,
+}
+
+// rawSnippet represents a single snippet of a VS Code snippets file. Prefix and body may either be a string or a list
+// of strings.
+type rawSnippet struct {
+	Scope       string          `json:"scope"`
+	Prefix      json.RawMessage `json:"prefix"`
+	Body        json.RawMessage `json:"body"`
+	Description string          `json:"description"`
+}
+
+// token is either plain text or a tabstop (tabstop >= 0) like $1, ${1:default} or ${1|a,b,c|}.
+type token struct {
+	text        string
+	tabstop     int
+	placeholder []token
+	choices     []string
+}
+
+// parseSnippetsFile parses a VS Code snippets file. For language snippet files (<language>.json), the language is
+// derived from the file name, for global snippet files (*.code-snippets) from the scope of each snippet.
+func parseSnippetsFile(path string, fileLanguageID string, contents []byte) ([]*snippetImpl, error) {
+	var rawSnippets map[string]rawSnippet
+	if err := json.Unmarshal(sanitizeJSON(contents), &rawSnippets); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rawSnippets))
+	for name := range rawSnippets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []*snippetImpl
+	for _, name := range names {
+		raw := rawSnippets[name]
+
+		body := strings.Join(stringOrList(raw.Body), "\n")
+		if body == "" {
+			continue
+		}
+
+		languageIDs := []string{fileLanguageID}
+		if fileLanguageID == "" {
+			languageIDs = splitScope(raw.Scope)
+		}
+
+		result = append(result, &snippetImpl{
+			id:         idutil.FormatSnippetID(fmt.Sprintf("%s#%s", path, name), idPrefix),
+			title:      name,
+			content:    body,
+			tags:       stringOrList(raw.Prefix),
+			language:   mapLanguage(languageIDs),
+			languages:  languageIDs,
+			parameters: parseParameters(parseBody(body)),
+			metadata:   model.SnippetMetadata{Description: raw.Description, Manager: Key, Source: path, ReadOnly: true},
+		})
+	}
+
+	return result, nil
+}
+
+func stringOrList(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return []string{}
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list
+	}
+	return []string{}
+}
+
+func splitScope(scope string) []string {
+	var result []string
+	for _, s := range strings.Split(scope, scopeSeparator) {
+		if s = strings.TrimSpace(s); s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func mapLanguage(languageIDs []string) model.Language {
+	for _, id := range languageIDs {
+		if l, ok := languageMapping[id]; ok {
+			return l
+		}
+	}
+	return model.LanguageText
+}
+
+// sanitizeJSON removes comments and trailing commas since VS Code accepts both in snippet files.
+func sanitizeJSON(contents []byte) []byte {
+	var result bytes.Buffer
+	inString := false
+
+	for i := 0; i < len(contents); i++ {
+		c := contents[i]
+
+		switch {
+		case inString:
+			result.WriteByte(c)
+			if c == '\\' && i+1 < len(contents) {
+				i++
+				result.WriteByte(contents[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			result.WriteByte(c)
+		case c == '/' && i+1 < len(contents) && contents[i+1] == '/':
+			for i < len(contents) && contents[i] != '\n' {
+				i++
+			}
+			if i < len(contents) {
+				result.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(contents) && contents[i+1] == '*':
+			end := bytes.Index(contents[i+2:], []byte("*/"))
+			if end < 0 {
+				i = len(contents)
+			} else {
+				i += end + 3
+			}
+		case c == ',' && nextSignificantIsClosing(contents[i+1:]):
+			// drop trailing comma
+		default:
+			result.WriteByte(c)
+		}
+	}
+
+	return result.Bytes()
+}
+
+func nextSignificantIsClosing(contents []byte) bool {
+	trimmed := bytes.TrimLeft(contents, " \t\r\n")
+	for bytes.HasPrefix(trimmed, []byte("//")) || bytes.HasPrefix(trimmed, []byte("/*")) {
+		if bytes.HasPrefix(trimmed, []byte("//")) {
+			end := bytes.IndexByte(trimmed, '\n')
+			if end < 0 {
+				return false
+			}
+			trimmed = bytes.TrimLeft(trimmed[end:], " \t\r\n")
+		} else {
+			end := bytes.Index(trimmed, []byte("*/"))
+			if end < 0 {
+				return false
+			}
+			trimmed = bytes.TrimLeft(trimmed[end+2:], " \t\r\n")
+		}
+	}
+	return len(trimmed) > 0 && (trimmed[0] == '}' || trimmed[0] == ']')
+}
+
+// parseBody splits the body of a snippet into plain text and tabstops. Variables (e.g., $TM_FILENAME or ${HOME}) are
+// kept as plain text since they are most likely meant to be evaluated by the shell.
+func parseBody(body string) []token {
+	tokens, _ := parseTokens([]rune(body), 0, false)
+	return tokens
+}
+
+func parseTokens(runes []rune, i int, inPlaceholder bool) ([]token, int) {
+	var result []token
+	var text strings.Builder
+
+	flushText := func() {
+		if text.Len() > 0 {
+			result = append(result, token{text: text.String(), tabstop: -1})
+			text.Reset()
+		}
+	}
+
+	for i < len(runes) {
+		c := runes[i]
+
+		switch {
+		case c == '\\' && i+1 < len(runes) && strings.ContainsRune(`$}\`, runes[i+1]):
+			text.WriteRune(runes[i+1])
+			i += 2
+		case c == '}' && inPlaceholder:
+			flushText()
+			return result, i + 1
+		case c == '$' && i+1 < len(runes) && isDigit(runes[i+1]):
+			flushText()
+			number, next := readNumber(runes, i+1)
+			result = append(result, token{tabstop: number})
+			i = next
+		case c == '$' && i+2 < len(runes) && runes[i+1] == '{' && isDigit(runes[i+2]):
+			flushText()
+			var t token
+			t, i = parseTabstop(runes, i+2)
+			result = append(result, t)
+		case c == '$' && i+1 < len(runes) && runes[i+1] == '{':
+			end := matchingBrace(runes, i+1)
+			text.WriteString(string(runes[i:end]))
+			i = end
+		default:
+			text.WriteRune(c)
+			i++
+		}
+	}
+
+	flushText()
+	return result, i
+}
+
+// parseTabstop parses a tabstop starting at the first digit after '${'.
+func parseTabstop(runes []rune, start int) (token, int) {
+	number, i := readNumber(runes, start)
+	t := token{tabstop: number}
+
+	if i >= len(runes) {
+		return t, i
+	}
+
+	switch runes[i] {
+	case '}':
+		return t, i + 1
+	case ':':
+		t.placeholder, i = parseTokens(runes, i+1, true)
+		return t, i
+	case '|':
+		end := strings.Index(string(runes[i+1:]), "|}")
+		if end >= 0 {
+			t.choices = splitChoices(string(runes[i+1 : i+1+end]))
+			return t, i + 1 + end + 2
+		}
+	}
+
+	// unsupported syntax (e.g., transformations) - skip the remainder of the tabstop
+	return t, matchingBrace(runes, start-1)
+}
+
+func splitChoices(s string) []string {
+	var result []string
+	var current strings.Builder
+	runes := []rune(s)
+
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && i+1 < len(runes):
+			i++
+			current.WriteRune(runes[i])
+		case runes[i] == ',':
+			result = append(result, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(runes[i])
+		}
+	}
+	return append(result, current.String())
+}
+
+// matchingBrace returns the index after the closing brace matching the opening brace at index i.
+func matchingBrace(runes []rune, i int) int {
+	depth := 0
+	for ; i < len(runes); i++ {
+		switch runes[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth <= 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(runes)
+}
+
+func readNumber(runes []rune, i int) (int, int) {
+	start := i
+	for i < len(runes) && isDigit(runes[i]) {
+		i++
+	}
+	number, _ := strconv.Atoi(string(runes[start:i]))
+	return number, i
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// parseParameters returns a parameter for each distinct tabstop ordered by its number. The final cursor position
+// ($0) is not mapped to a parameter.
+func parseParameters(tokens []token) []model.Parameter {
+	parametersByTabstop := map[int]*model.Parameter{}
+	var tabstops []int
+
+	var visit func(tokens []token)
+	visit = func(tokens []token) {
+		for _, t := range tokens {
+			if t.tabstop <= 0 {
+				continue
+			}
+
+			parameter, ok := parametersByTabstop[t.tabstop]
+			if !ok {
+				key := strconv.Itoa(t.tabstop)
+				parameter = &model.Parameter{Key: key, Name: "$" + key}
+				parametersByTabstop[t.tabstop] = parameter
+				tabstops = append(tabstops, t.tabstop)
+			}
+
+			if len(parameter.Values) == 0 && len(t.choices) > 0 {
+				parameter.Values = t.choices
+			}
+			if parameter.DefaultValue == "" && len(t.placeholder) > 0 {
+				parameter.DefaultValue = render(t.placeholder, nil)
+			}
+
+			visit(t.placeholder)
+		}
+	}
+	visit(tokens)
+
+	sort.Ints(tabstops)
+	result := make([]model.Parameter, len(tabstops))
+	for i, tabstop := range tabstops {
+		result[i] = *parametersByTabstop[tabstop]
+	}
+	return result
+}
+
+// render returns the text of the given tokens. Tabstops are replaced by the given values or their default otherwise.
+func render(tokens []token, values map[int]string) string {
+	var sb strings.Builder
+	for _, t := range tokens {
+		value, hasValue := values[t.tabstop]
+		switch {
+		case t.tabstop < 0:
+			sb.WriteString(t.text)
+		case hasValue:
+			sb.WriteString(value)
+		case len(t.choices) > 0:
+			sb.WriteString(t.choices[0])
+		default:
+			sb.WriteString(render(t.placeholder, values))
+		}
+	}
+	return sb.String()
+}
+
+func formatBody(body string, values []string) string {
+	tokens := parseBody(body)
+	parameters := parseParameters(tokens)
+
+	valuesByTabstop := map[int]string{}
+	for i := range parameters {
+		if i < len(values) {
+			tabstop, _ := strconv.Atoi(parameters[i].Key)
+			valuesByTabstop[tabstop] = values[i]
+		}
+	}
+
+	return render(tokens, valuesByTabstop)
+}
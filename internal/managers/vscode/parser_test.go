@@ -0,0 +1,85 @@
+package vscode
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const (
+	testDataUserSnippets = "testdata/config/Code/User/snippets"
+	testDataShellFile    = testDataUserSnippets + "/shellscript.json"
+)
+
+func Test_parseSnippetsFile(t *testing.T) {
+	contents, err := os.ReadFile(testDataShellFile)
+	assert.NoError(t, err)
+
+	snippets, err := parseSnippetsFile(testDataShellFile, "shellscript", contents)
+	assert.NoError(t, err)
+	assert.Len(t, snippets, 2)
+
+	assert.Equal(t, "Docker run", snippets[0].GetTitle())
+	assert.Equal(t, []string{"drun", "docker"}, snippets[0].GetTags())
+	assert.Equal(t, model.LanguageBash, snippets[0].GetLanguage())
+	assert.Equal(t, "docker run -p ${1:8080}:${2:80} ${3|nginx,httpd|} \\$HOME\necho $1 ${HOME}$0", snippets[0].GetContent())
+	assert.Equal(t, []model.Parameter{
+		{Key: "1", Name: "$1", DefaultValue: "8080"},
+		{Key: "2", Name: "$2", DefaultValue: "80"},
+		{Key: "3", Name: "$3", Values: []string{"nginx", "httpd"}},
+	}, snippets[0].GetParameters())
+	assert.Equal(t, model.SnippetMetadata{
+		Description: "Run a container",
+		Manager:     Key,
+		Source:      testDataShellFile,
+		ReadOnly:    true,
+	}, snippets[0].GetMetadata())
+
+	assert.Equal(t, "Say hello", snippets[1].GetTitle())
+	assert.Equal(t, []string{"hello"}, snippets[1].GetTags())
+	assert.Equal(t, []model.Parameter{
+		{Key: "1", Name: "$1", DefaultValue: "dear world"},
+		{Key: "2", Name: "$2", DefaultValue: "dear"},
+	}, snippets[1].GetParameters())
+}
+
+func Test_parseSnippetsFile_invalid(t *testing.T) {
+	_, err := parseSnippetsFile("foo.json", "foo", []byte("{ invalid"))
+	assert.Error(t, err)
+}
+
+func Test_formatBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		values   []string
+		expected string
+	}{
+		{name: "values", body: "docker run -p ${1:8080}:${2:80} ${3|nginx,httpd|}", values: []string{"1", "2", "httpd"}, expected: "docker run -p 1:2 httpd"},
+		{name: "defaults", body: "docker run -p ${1:8080}:${2:80} ${3|nginx,httpd|}", expected: "docker run -p 8080:80 nginx"},
+		{name: "mirrored tabstop", body: "echo ${1:foo} $1", values: []string{"bar"}, expected: "echo bar bar"},
+		{name: "escapes and variables", body: "echo \\$HOME ${HOME} $USER \\}$0", expected: "echo $HOME ${HOME} $USER }"},
+		{name: "nested", body: "echo ${1:a ${2:b}}", values: []string{"", "x"}, expected: "echo "},
+		{name: "nested default", body: "echo ${1:a ${2:b}}", expected: "echo a b"},
+		{name: "choices with escaped comma", body: "${1|a\\,b,c|}", expected: "a,b"},
+		{name: "unsupported transformation", body: "echo ${1/(.*)/${1:/upcase}/} end", expected: "echo  end"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatBody(tt.body, tt.values))
+		})
+	}
+}
+
+func Test_sanitizeJSON(t *testing.T) {
+	input := `{
+  // comment with "quotes"
+  "a": "http://example.com", /* block */
+  "b": ["x", "y",],
+}`
+	assert.JSONEq(t, `{"a": "http://example.com", "b": ["x", "y"]}`, string(sanitizeJSON([]byte(input))))
+}
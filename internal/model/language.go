@@ -3,10 +3,40 @@ package model
 type Language int
 
 const (
-	LanguageUnknown  = Language(0)
-	LanguageBash     = Language(1)
-	LanguageYAML     = Language(2)
-	LanguageMarkdown = Language(3)
-	LanguageText     = Language(4)
-	LanguageTOML     = Language(5)
+	LanguageUnknown    = Language(0)
+	LanguageBash       = Language(1)
+	LanguageYAML       = Language(2)
+	LanguageMarkdown   = Language(3)
+	LanguageText       = Language(4)
+	LanguageTOML       = Language(5)
+	LanguagePython     = Language(6)
+	LanguageRuby       = Language(7)
+	LanguageJavaScript = Language(8)
+	LanguagePowerShell = Language(9)
+	LanguagePerl       = Language(10)
+	LanguageSQL        = Language(11)
+	LanguageDockerfile = Language(12)
+	LanguageJSON       = Language(13)
 )
+
+var languageNames = map[Language]string{
+	LanguageBash:       "bash",
+	LanguageYAML:       "yaml",
+	LanguageMarkdown:   "markdown",
+	LanguageText:       "text",
+	LanguageTOML:       "toml",
+	LanguagePython:     "python",
+	LanguageRuby:       "ruby",
+	LanguageJavaScript: "javascript",
+	LanguagePowerShell: "powershell",
+	LanguagePerl:       "perl",
+	LanguageSQL:        "sql",
+	LanguageDockerfile: "dockerfile",
+	LanguageJSON:       "json",
+}
+
+// Name returns the lowercase name of the language as used in the config file, e.g., to configure the interpreter of a
+// language. An empty string is returned for LanguageUnknown.
+func (l Language) Name() string {
+	return languageNames[l]
+}
@@ -15,6 +15,13 @@ type Parameter struct {
 	Description  string
 	DefaultValue string
 	Values       []string
+
+	// ValuesCommand is a shell command whose output lines are offered as values of the parameter.
+	ValuesCommand string
+	// DefaultCommand is a shell command whose output is used as default value of the parameter.
+	DefaultCommand string
+	// CommandError describes why ValuesCommand or DefaultCommand failed. It is shown next to the parameter in the form.
+	CommandError string
 }
 
 type ParameterValue struct {
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type SnippetParamMode int
 
 const (
@@ -20,4 +22,26 @@ type Snippet interface {
 	GetLanguage() Language
 	GetParameters() []Parameter
 	Format([]string, SnippetFormatOptions) string
+	GetMetadata() SnippetMetadata
+}
+
+// SnippetMetadata describes where a snippet originates from. All fields are optional since not every manager provides
+// all information.
+type SnippetMetadata struct {
+	Description string
+	Manager     ManagerKey
+	// Source is the location of the snippet, e.g., a file path or URL.
+	Source   string
+	Created  time.Time
+	Modified time.Time
+	// ReadOnly is true if the snippet cannot be modified via snipkit.
+	ReadOnly bool
+}
+
+// SnippetDraft holds the properties of a snippet which can be set when creating or updating a snippet.
+type SnippetDraft struct {
+	Title    string
+	Content  string
+	Tags     []string
+	Language Language
 }
@@ -25,12 +25,14 @@ type (
 )
 
 const (
-	hintTypeName         = hintTypeDescriptor("Name")
-	hintTypeDescription  = hintTypeDescriptor("Description")
-	hintTypeDefaultValue = hintTypeDescriptor("Default")
-	hintTypeParamType    = hintTypeDescriptor("Type")
-	hintTypeValues       = hintTypeDescriptor("Values")
-	hintTypeInvalid      = hintTypeDescriptor("invalid")
+	hintTypeName           = hintTypeDescriptor("Name")
+	hintTypeDescription    = hintTypeDescriptor("Description")
+	hintTypeDefaultValue   = hintTypeDescriptor("Default")
+	hintTypeParamType      = hintTypeDescriptor("Type")
+	hintTypeValues         = hintTypeDescriptor("Values")
+	hintTypeValuesCommand  = hintTypeDescriptor("ValuesCommand")
+	hintTypeDefaultCommand = hintTypeDescriptor("DefaultCommand")
+	hintTypeInvalid        = hintTypeDescriptor("invalid")
 
 	regexNamedGroupVariable = regexNamedGroup("varname")
 	regexNamedGroupType     = regexNamedGroup("key")
@@ -122,12 +124,14 @@ func hintsToParameters(hints []hint) []model.Parameter {
 		}
 
 		result = append(result, model.Parameter{
-			Key:          varName,
-			Name:         name,
-			Description:  allHintValues.descriptions[varName],
-			DefaultValue: allHintValues.defaults[varName],
-			Values:       allHintValues.values[varName],
-			Type:         mapToParameterType(allHintValues.types[varName]),
+			Key:            varName,
+			Name:           name,
+			Description:    allHintValues.descriptions[varName],
+			DefaultValue:   allHintValues.defaults[varName],
+			Values:         allHintValues.values[varName],
+			ValuesCommand:  allHintValues.valuesCommands[varName],
+			DefaultCommand: allHintValues.defaultCommands[varName],
+			Type:           mapToParameterType(allHintValues.types[varName]),
 		})
 	}
 
@@ -135,21 +139,25 @@ func hintsToParameters(hints []hint) []model.Parameter {
 }
 
 type hintValues struct {
-	variableNames []string
-	names         map[string]string
-	descriptions  map[string]string
-	defaults      map[string]string
-	values        map[string][]string
-	types         map[string]string
+	variableNames   []string
+	names           map[string]string
+	descriptions    map[string]string
+	defaults        map[string]string
+	values          map[string][]string
+	valuesCommands  map[string]string
+	defaultCommands map[string]string
+	types           map[string]string
 }
 
 func toHintValues(hints []hint) hintValues {
 	result := hintValues{
-		names:        map[string]string{},
-		descriptions: map[string]string{},
-		defaults:     map[string]string{},
-		values:       map[string][]string{},
-		types:        map[string]string{},
+		names:           map[string]string{},
+		descriptions:    map[string]string{},
+		defaults:        map[string]string{},
+		values:          map[string][]string{},
+		valuesCommands:  map[string]string{},
+		defaultCommands: map[string]string{},
+		types:           map[string]string{},
 	}
 
 	for _, h := range hints {
@@ -174,6 +182,10 @@ func toHintValues(hints []hint) hintValues {
 			result.defaults[h.variable] = h.value
 		case hintTypeParamType:
 			result.types[h.variable] = h.value
+		case hintTypeValuesCommand:
+			result.valuesCommands[h.variable] = h.value
+		case hintTypeDefaultCommand:
+			result.defaultCommands[h.variable] = h.value
 
 		case hintTypeValues:
 			if parsedValues := stringutil.SplitWithEscape(h.value, ',', '\\', true); len(parsedValues) > 0 {
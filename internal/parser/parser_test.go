@@ -37,6 +37,12 @@ echo "1 -> ${VAR1}"
 # ${PW} Type: PASSWORD
 echo ${PATH}
 echo ${PW}
+`
+	testSnippet5 = `
+# ${NS} Name: Namespace
+# ${NS} ValuesCommand: kubectl get ns -o name | cut -d/ -f2
+# ${NS} DefaultCommand: kubectl config view --minify -o jsonpath='{..namespace}'
+kubectl get pods -n ${NS}
 `
 )
 
@@ -65,6 +71,14 @@ func Test_parseParameters(t *testing.T) {
 			{Key: "PATH", Name: "PATH", Type: model.ParameterTypePath},
 			{Key: "PW", Name: "PW", Type: model.ParameterTypePassword},
 		}},
+		{name: "with commands", snippet: testSnippet5, parameters: []model.Parameter{
+			{
+				Key:            "NS",
+				Name:           "Namespace",
+				ValuesCommand:  "kubectl get ns -o name | cut -d/ -f2",
+				DefaultCommand: "kubectl config view --minify -o jsonpath='{..namespace}'",
+			},
+		}},
 	}
 
 	for _, tt := range tests {
@@ -45,6 +45,9 @@ type CapturedOutput struct {
 	Stdout   string
 	ExitCode int
 	Duration time.Duration
+	// Quit is true if the user pressed Ctrl+C after the script finished in order to quit instead of returning to the
+	// assistant.
+	Quit bool
 }
 
 // helpLine renders a styled help line.
@@ -299,6 +302,7 @@ func runWithAssistantUI(cmd *exec.Cmd, ptmx *os.File, outputBuf *bytes.Buffer, d
 	// Channels for stdin coordination
 	scriptDone := make(chan struct{})
 	enterPressed := make(chan struct{})
+	quit := false
 
 	// Single stdin reader that forwards to PTY during execution,
 	// then waits for Enter/Ctrl+C after script finishes
@@ -319,12 +323,10 @@ func runWithAssistantUI(cmd *exec.Cmd, ptmx *os.File, outputBuf *bytes.Buffer, d
 							close(enterPressed)
 							return
 						}
-						if buf[i] == ctrlC { // Ctrl+C - clear help line, restore terminal and exit
-							_, _ = os.Stdout.WriteString("\r\x1b[2K\x1b[A\x1b[2K") // Clear line, move up, clear that line too
-							if rawModeEnabled {
-								_ = term.Restore(int(os.Stdin.Fd()), oldState)
-							}
-							os.Exit(0)
+						if buf[i] == ctrlC { // Ctrl+C - quit so that the caller can exit with the exit code of the script
+							quit = true
+							close(enterPressed)
+							return
 						}
 					}
 				default:
@@ -366,7 +368,7 @@ func runWithAssistantUI(cmd *exec.Cmd, ptmx *os.File, outputBuf *bytes.Buffer, d
 	// Signal that script is done - stdin reader will now wait for Enter
 	close(scriptDone)
 
-	// Wait for Enter key from the stdin reader goroutine
+	// Wait for Enter or Ctrl+C from the stdin reader goroutine
 	<-enterPressed
 
 	// Clear the help line and the padding line above it
@@ -399,6 +401,7 @@ func runWithAssistantUI(cmd *exec.Cmd, ptmx *os.File, outputBuf *bytes.Buffer, d
 		Stdout:   outputToReturn,
 		ExitCode: exitCode,
 		Duration: duration,
+		Quit:     quit,
 	}
 }
 
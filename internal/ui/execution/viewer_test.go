@@ -155,6 +155,46 @@ func Test_RunWithViewer_Assistant(t *testing.T) {
 	}
 }
 
+func Test_RunWithViewer_Assistant_Quit(t *testing.T) {
+	pty, tty, err := pseudotty.Open()
+	require.NoError(t, err)
+	defer func() { _ = pty.Close() }()
+	defer func() { _ = tty.Close() }()
+
+	term := vt10x.New(vt10x.WithWriter(tty))
+	c, err := expect.NewConsole(expect.WithStdin(pty), expect.WithStdout(term), expect.WithCloser(pty, tty))
+	require.NoError(t, err)
+	defer func() { _ = c.Close() }()
+
+	done := make(chan *CapturedOutput, 1)
+	go func() {
+		oldStdin, oldStdout := os.Stdin, os.Stdout
+		os.Stdin = c.Tty()
+		os.Stdout = c.Tty()
+		defer func() {
+			os.Stdin = oldStdin
+			os.Stdout = oldStdout
+		}()
+
+		cmd := exec.Command("/bin/sh", "-c", "exit 3")
+		done <- RunWithViewer(cmd, true)
+	}()
+
+	// Give time for script to execute
+	time.Sleep(200 * time.Millisecond)
+
+	// Ctrl+C after the script finished returns the exit code instead of terminating the process
+	_, _ = c.Send("\x03")
+
+	select {
+	case result := <-done:
+		assert.True(t, result.Quit)
+		assert.Equal(t, 3, result.ExitCode)
+	case <-time.After(5 * time.Second):
+		t.Fatal("assistant execution did not complete in time")
+	}
+}
+
 func Test_RunWithViewer_ExitCode(t *testing.T) {
 	pty, tty, err := pseudotty.Open()
 	require.NoError(t, err)
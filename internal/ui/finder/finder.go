@@ -421,7 +421,7 @@ func (f *Finder) updateMatches() {
 		}
 
 		if scoresProvided {
-			sort.Slice(newMatched, func(i, j int) bool {
+			sort.SliceStable(newMatched, func(i, j int) bool {
 				return newMatched[i].score > newMatched[j].score
 			})
 		}
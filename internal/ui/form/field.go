@@ -38,6 +38,9 @@ type FieldModel struct {
 
 	selectedPathSuggestion string
 
+	// err is shown below the field, e.g., if the values of the parameter could not be computed
+	err string
+
 	keyMap FieldKeyMap
 
 	labelWidth int
@@ -90,6 +93,11 @@ func (m *FieldModel) SetValue(text string) {
 	m.field.SetValue(text)
 }
 
+// SetError sets an error message which is shown below the field.
+func (m *FieldModel) SetError(err string) {
+	m.err = err
+}
+
 func (m *FieldModel) Focus() tea.Cmd {
 	// Always filter options on focus to initialize suggestions
 	m.filterOptions()
@@ -315,6 +323,14 @@ func (m *FieldModel) View() string {
 		f = lipgloss.JoinVertical(lipgloss.Left, f, options)
 	}
 
+	if m.err != "" {
+		errView := lipgloss.NewStyle().
+			MarginLeft(lipgloss.Width(label)).
+			Foreground(m.styler.ErrorColor().Value()).
+			Render("! " + m.err)
+		f = lipgloss.JoinVertical(lipgloss.Left, f, errView)
+	}
+
 	return f
 }
 
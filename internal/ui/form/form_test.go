@@ -148,3 +148,22 @@ func Test_ShowForm_NextTabAndThenCancel(t *testing.T) {
 		assert.Len(t, result, 0)
 	})
 }
+
+func Test_ShowForm_commandError(t *testing.T) {
+	termtest.RunTerminalTest(t, func(c *termtest.Console) {
+		c.ExpectString("This snippet requires parameters")
+		c.ExpectString("! kubectl: command not found")
+
+		c.Send("default")
+		c.SendKey(termtest.KeyEnter)
+		c.SendKey(termtest.KeyEnter)
+	}, func(stdio termutil.Stdio) {
+		result, ok := Show(
+			[]internalModel.Parameter{{Key: "Namespace", CommandError: "kubectl: command not found"}},
+			nil, "ok", WithIn(stdio.In), WithOut(stdio.Out),
+		)
+
+		assert.Equal(t, true, ok)
+		assert.Equal(t, []string{"default"}, result)
+	})
+}
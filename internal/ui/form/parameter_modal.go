@@ -72,6 +72,9 @@ func createFields(
 		}
 
 		fields[i] = NewField(styler, name, param.Description, param.Type, param.Values, fs)
+		if param.CommandError != "" {
+			fields[i].SetError(param.CommandError)
+		}
 
 		// Pre-fill default value if present
 		if param.DefaultValue != "" {
@@ -13,13 +13,25 @@ import (
 
 	"github.com/lemoony/snipkit/internal/model"
 	"github.com/lemoony/snipkit/internal/ui/finder"
+	"github.com/lemoony/snipkit/internal/utils/langdetect"
 )
 
+const previewTitleDefault = "Preview"
+
 var lexerMapping = map[model.Language]string{
 	model.LanguageYAML:     "yaml",
 	model.LanguageBash:     "bash",
 	model.LanguageMarkdown: "markdown",
 	model.LanguageTOML:     "toml",
+
+	model.LanguagePython:     "python",
+	model.LanguageRuby:       "ruby",
+	model.LanguageJavaScript: "javascript",
+	model.LanguagePowerShell: "powershell",
+	model.LanguagePerl:       "perl",
+	model.LanguageSQL:        "sql",
+	model.LanguageDockerfile: "docker",
+	model.LanguageJSON:       "json",
 }
 
 func (t *tuiImpl) ShowLookup(snippets []model.Snippet, fuzzySearch bool) int {
@@ -46,8 +58,10 @@ func (t *tuiImpl) ShowLookup(snippets []model.Snippet, fuzzySearch bool) int {
 		SetChangedFunc(func(index int) {
 			if index >= 0 {
 				preview.SetText("")
+				preview.SetTitle(previewTitle(snippets[index]))
 
-				l := lexers.Get(lexerMapping[snippets[index].GetLanguage()])
+				language := langdetect.Resolve(snippets[index].GetLanguage(), snippets[index].GetContent())
+				l := lexers.Get(lexerMapping[language])
 				if l == nil {
 					l = lexers.Fallback
 				}
@@ -63,6 +77,7 @@ func (t *tuiImpl) ShowLookup(snippets []model.Snippet, fuzzySearch bool) int {
 				preview.ScrollToBeginning()
 			} else {
 				preview.SetText("")
+				preview.SetTitle(previewTitleDefault)
 			}
 		})
 
@@ -86,12 +101,25 @@ func (t *tuiImpl) ShowLookup(snippets []model.Snippet, fuzzySearch bool) int {
 func createPreview() *tview.TextView {
 	result := tview.NewTextView()
 	result.SetBorder(true)
-	result.SetTitle("Preview")
+	result.SetTitle(previewTitleDefault)
 	result.SetDynamicColors(true)
 	result.SetBorderPadding(0, 0, 1, 0)
 	return result
 }
 
+// previewTitle shows the manager and source of the snippet so that snippets with similar titles can be distinguished.
+func previewTitle(snippet model.Snippet) string {
+	metadata := snippet.GetMetadata()
+	switch {
+	case metadata.Manager != "" && metadata.Source != "":
+		return fmt.Sprintf("%s (%s: %s)", previewTitleDefault, metadata.Manager, tview.Escape(metadata.Source))
+	case metadata.Manager != "":
+		return fmt.Sprintf("%s (%s)", previewTitleDefault, metadata.Manager)
+	default:
+		return previewTitleDefault
+	}
+}
+
 func (t *tuiImpl) getPreviewFormatterAndStyle() (chroma.Formatter, *chroma.Style) {
 	f := formatters.Get("terminal")
 	if f == nil {
@@ -3,7 +3,11 @@ package ui
 import (
 	"testing"
 
+	"github.com/alecthomas/chroma/lexers"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+	"github.com/lemoony/snipkit/internal/utils/testutil"
 )
 
 func Test_fuzzyMatcher(t *testing.T) {
@@ -51,3 +55,19 @@ func Test_fuzzyMatcher(t *testing.T) {
 		})
 	}
 }
+
+func Test_previewTitle(t *testing.T) {
+	assert.Equal(t, "Preview", previewTitle(testutil.TestSnippet{}))
+	assert.Equal(t, "Preview (fslibrary)", previewTitle(testutil.TestSnippet{
+		Metadata: model.SnippetMetadata{Manager: "fslibrary"},
+	}))
+	assert.Equal(t, "Preview (fslibrary: /tmp/foo.sh)", previewTitle(testutil.TestSnippet{
+		Metadata: model.SnippetMetadata{Manager: "fslibrary", Source: "/tmp/foo.sh"},
+	}))
+}
+
+func Test_lexerMapping(t *testing.T) {
+	for language, name := range lexerMapping {
+		assert.NotNil(t, lexers.Get(name), "no lexer for language %s", language.Name())
+	}
+}
@@ -38,6 +38,7 @@ const (
 
 	OkButtonExecute = OkButton("Execute")
 	OkButtonPrint   = OkButton("Print")
+	OkButtonSave    = OkButton("Save")
 )
 
 // TUIOption configures a TUI.
@@ -39,11 +39,15 @@ const (
 	managerAddConfigConfirm = "manager_add_config_confirm.gotmpl"
 	managerAddConfigResult  = "manager_add_config_result.gotmpl"
 	managerOAuthDeviceFlow  = "manager_oauth_device_flow.gotmpl"
+	managerLoadFailed       = "manager_load_failed.gotmpl"
 
 	assistantNoneEnabled        = "assistant_none_enabled.gotmpl"
 	assistantUpdateConfigResult = "assistant_update_config_result.gotmpl"
 	assistantSnippetSaved       = "assistant_snippet_saved.gotmpl"
 
+	snippetDeleteConfirm = "snippet_delete_confirm.gotmpl"
+	snippetWriteResult   = "snippet_write_result.gotmpl"
+
 	snippetWidthMargin = 10
 )
 
@@ -205,6 +209,15 @@ func ManagerOauthDeviceFlow(host string, code string) Printable {
 	}
 }
 
+// ManagerLoadFailed warns that a manager could not be created or did not provide its snippets. The snippets of all
+// other managers are still available.
+func ManagerLoadFailed(manager string, reason string) Printable {
+	return Printable{
+		template: managerLoadFailed,
+		data:     map[string]interface{}{"manager": manager, "reason": reason},
+	}
+}
+
 func AssistantNoneEnabled() Printable {
 	return Printable{template: assistantNoneEnabled}
 }
@@ -223,6 +236,23 @@ func AssistantSnippetSaved(title, path string) Printable {
 	}
 }
 
+func SnippetDeleteConfirm(title string) Confirm {
+	return Confirm{
+		Prompt:   "Do you want to delete the snippet?",
+		template: snippetDeleteConfirm,
+		data:     map[string]interface{}{"snippetTitle": title},
+	}
+}
+
+// SnippetWriteResult reports the result of a snippet modification. The action describes the modification in past
+// tense, e.g., "created" or "deleted".
+func SnippetWriteResult(modified bool, action, title string) Printable {
+	return Printable{
+		template: snippetWriteResult,
+		data:     map[string]interface{}{"modified": modified, "action": action, "snippetTitle": title},
+	}
+}
+
 func ConfigNotFound(configPath string) Printable {
 	return Printable{
 		template: configNotFound,
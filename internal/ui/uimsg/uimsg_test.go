@@ -123,6 +123,17 @@ func Test_ThemesDeleteResult(t *testing.T) {
 	assert.Contains(t, render(ThemesDeleteResult(true, testThemesPath)), testThemesPath)
 }
 
+func Test_SnippetDeleteConfirm(t *testing.T) {
+	c := SnippetDeleteConfirm("Some snippet")
+	assert.Equal(t, "Do you want to delete the snippet?", c.Prompt)
+	assert.Contains(t, testutil.StripANSI(c.Header(testStyle, 0)), "Some snippet")
+}
+
+func Test_SnippetWriteResult(t *testing.T) {
+	assert.Equal(t, "The snippet was created: Some snippet", testutil.StripANSI(render(SnippetWriteResult(true, "created", "Some snippet"))))
+	assert.Equal(t, "The snippet was not deleted: Some snippet", render(SnippetWriteResult(false, "deleted", "Some snippet")))
+}
+
 func Test_ManagerConfigAddConfirm(t *testing.T) {
 	oldConfig := "old: yaml"
 	newConfig := "new: yaml"
@@ -140,6 +151,14 @@ func Test_ManagerOauthDeviceFlow(t *testing.T) {
 	assert.Contains(t, render(ManagerOauthDeviceFlow("github.com", "1234-5678")), "1234-5678")
 }
 
+func Test_ManagerLoadFailed(t *testing.T) {
+	assert.Equal(
+		t,
+		"Warning: the snippets of pet are not available: file not found",
+		testutil.StripANSI(render(ManagerLoadFailed("pet", "file not found"))),
+	)
+}
+
 func Test_MAssistantUpdateConfigResult(t *testing.T) {
 	assert.Contains(t, render(AssistantUpdateConfigResult(true, testCfgPath)), testCfgPath)
 }
@@ -0,0 +1,179 @@
+package langdetect
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/chroma/lexers"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+const (
+	shebangPrefix = "#!"
+	envPrefix     = "/usr/bin/env "
+)
+
+var (
+	interpreterVersionRegex = regexp.MustCompile(`[\d.]+$`)
+
+	dockerfileRegex = regexp.MustCompile(`^(FROM|ARG)\s+\S+`)
+	sqlRegex        = regexp.MustCompile(
+		`(?i)^(SELECT|INSERT\s+INTO|UPDATE\s+\S+\s+SET|DELETE\s+FROM|(CREATE|ALTER|DROP)\s+(TABLE|INDEX|VIEW|DATABASE|SCHEMA)|WITH\s+\S+\s+AS)\b`,
+	)
+)
+
+// shebangMapping maps the name of the interpreter of a shebang line (without version suffix) to a language.
+var shebangMapping = map[string]model.Language{
+	"sh":         model.LanguageBash,
+	"bash":       model.LanguageBash,
+	"zsh":        model.LanguageBash,
+	"dash":       model.LanguageBash,
+	"ksh":        model.LanguageBash,
+	"fish":       model.LanguageBash,
+	"python":     model.LanguagePython,
+	"ruby":       model.LanguageRuby,
+	"node":       model.LanguageJavaScript,
+	"nodejs":     model.LanguageJavaScript,
+	"pwsh":       model.LanguagePowerShell,
+	"powershell": model.LanguagePowerShell,
+	"perl":       model.LanguagePerl,
+}
+
+// lexerMapping maps the name of a chroma lexer to a language.
+var lexerMapping = map[string]model.Language{
+	"Bash":       model.LanguageBash,
+	"Python":     model.LanguagePython,
+	"Python 2":   model.LanguagePython,
+	"Ruby":       model.LanguageRuby,
+	"JavaScript": model.LanguageJavaScript,
+	"PowerShell": model.LanguagePowerShell,
+	"Perl":       model.LanguagePerl,
+	"Docker":     model.LanguageDockerfile,
+	"JSON":       model.LanguageJSON,
+	"YAML":       model.LanguageYAML,
+	"TOML":       model.LanguageTOML,
+	"markdown":   model.LanguageMarkdown,
+}
+
+// heuristic detects a language if any of the significant lines of a snippet matches the regex.
+type heuristic struct {
+	language model.Language
+	regex    *regexp.Regexp
+}
+
+var heuristics = []heuristic{
+	{model.LanguagePython, regexp.MustCompile(`^(def \w+\(.*\):|from [\w.]+ import \w|print\(|if __name__ == )`)},
+	{model.LanguagePowerShell, regexp.MustCompile(`^(\$\w+\s*=|(Get|Set|New|Remove|Write|Invoke|Start|Stop)-[A-Z]\w+)`)},
+	{model.LanguageJavaScript, regexp.MustCompile(`console\.log\(|require\(['"]|^(const|let)\s+\w+\s+=\s.*;$`)},
+	{model.LanguagePerl, regexp.MustCompile(`^use (strict|warnings);|^my [$@%]\w+\s*=`)},
+	{model.LanguageRuby, regexp.MustCompile(`^puts\s|^require ['"]\w+['"]$|\.each\s+do\s*\|`)},
+}
+
+// Resolve returns the language of a snippet. If the language is unknown or text, it is detected based on the content.
+// If detection fails, the given language is returned.
+func Resolve(language model.Language, content string) model.Language {
+	if language != model.LanguageUnknown && language != model.LanguageText {
+		return language
+	}
+	if detected := Detect(content); detected != model.LanguageUnknown {
+		return detected
+	}
+	return language
+}
+
+// Detect infers the language of the content. A shebang line is considered first, followed by simple heuristics and
+// the analysers of the chroma lexers. If no language can be inferred, LanguageUnknown is returned.
+func Detect(content string) model.Language {
+	if language, ok := ShebangLanguage(content); ok {
+		return language
+	}
+
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return model.LanguageUnknown
+	}
+
+	if (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+		return model.LanguageJSON
+	}
+
+	lines := significantLines(trimmed)
+	if len(lines) > 0 && dockerfileRegex.MatchString(lines[0]) {
+		return model.LanguageDockerfile
+	}
+	if len(lines) > 0 && isSQL(lines[0], trimmed) {
+		return model.LanguageSQL
+	}
+
+	for _, h := range heuristics {
+		for _, line := range lines {
+			if h.regex.MatchString(line) {
+				return h.language
+			}
+		}
+	}
+
+	if lexer := lexers.Analyse(content); lexer != nil {
+		if language, ok := lexerMapping[lexer.Config().Name]; ok {
+			return language
+		}
+	}
+
+	return model.LanguageUnknown
+}
+
+// ShebangLanguage returns the language of the interpreter of the shebang line, e.g., LanguageRuby for
+// "#!/usr/bin/env ruby". Version suffixes of the interpreter are ignored.
+func ShebangLanguage(script string) (model.Language, bool) {
+	interpreter, ok := ShebangInterpreter(script)
+	if !ok {
+		return model.LanguageUnknown, false
+	}
+	language, ok := shebangMapping[interpreterVersionRegex.ReplaceAllString(filepath.Base(interpreter), "")]
+	return language, ok
+}
+
+// ShebangInterpreter returns the interpreter of the shebang line without any arguments, e.g., "bash" for
+// "#!/usr/bin/env bash" and "/bin/zsh" for "#!/bin/zsh -e". A shebang is only considered if followed by a newline.
+func ShebangInterpreter(script string) (string, bool) {
+	if !strings.HasPrefix(script, shebangPrefix) {
+		return "", false
+	}
+
+	idx := strings.Index(script, "\n")
+	if idx == -1 {
+		return "", false
+	}
+
+	shebang := strings.TrimSpace(script[len(shebangPrefix):idx])
+	shebang = strings.TrimSpace(strings.TrimPrefix(shebang, envPrefix))
+	if interpreter, _, ok := strings.Cut(shebang, " "); ok {
+		return interpreter, true
+	}
+	return shebang, shebang != ""
+}
+
+// significantLines returns all trimmed lines which are neither empty nor comments.
+func significantLines(content string) []string {
+	var result []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "--") {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// isSQL returns true if the line starts with a SQL statement. Since some keywords are valid shell commands as well
+// (e.g., select), lowercase statements are only considered SQL if terminated with a semicolon.
+func isSQL(line string, content string) bool {
+	if !sqlRegex.MatchString(line) {
+		return false
+	}
+	keyword, _, _ := strings.Cut(line, " ")
+	return keyword == strings.ToUpper(keyword) || strings.HasSuffix(content, ";")
+}
@@ -0,0 +1,104 @@
+package langdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lemoony/snipkit/internal/model"
+)
+
+func Test_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected model.Language
+	}{
+		{name: "empty", content: "  \n", expected: model.LanguageUnknown},
+		{name: "plain command", content: "echo foo", expected: model.LanguageUnknown},
+		{name: "bash shebang", content: "#!/bin/bash\necho foo", expected: model.LanguageBash},
+		{name: "env shebang", content: "#!/usr/bin/env zsh\necho foo", expected: model.LanguageBash},
+		{name: "python shebang", content: "#!/usr/bin/env python3\nimport os", expected: model.LanguagePython},
+		{name: "versioned shebang", content: "#!/usr/bin/python3.11 -u\nimport os", expected: model.LanguagePython},
+		{name: "node shebang", content: "#!/usr/bin/env node\nfoo()", expected: model.LanguageJavaScript},
+		{name: "unknown shebang", content: "#!/usr/bin/env awk -f\n{ print $1 }", expected: model.LanguageUnknown},
+		{name: "json object", content: `{"foo": [1, 2]}`, expected: model.LanguageJSON},
+		{name: "json array", content: "[\n  \"foo\"\n]\n", expected: model.LanguageJSON},
+		{name: "invalid json", content: "{ echo foo; }", expected: model.LanguageUnknown},
+		{name: "dockerfile", content: "# syntax=docker/dockerfile:1\nFROM alpine:3\nRUN apk add curl", expected: model.LanguageDockerfile},
+		{name: "sql", content: "SELECT *\nFROM users", expected: model.LanguageSQL},
+		{name: "lowercase sql", content: "-- all users\nselect * from users;", expected: model.LanguageSQL},
+		{name: "bash select", content: "select opt in a b; do echo $opt; done", expected: model.LanguageUnknown},
+		{name: "python", content: "#\n# Say hello\n#\ndef hello(name):\n    print(f'hello {name}')", expected: model.LanguagePython},
+		{name: "powershell", content: "Get-ChildItem -Path . -Recurse", expected: model.LanguagePowerShell},
+		{name: "javascript", content: "const fs = require('fs');", expected: model.LanguageJavaScript},
+		{name: "perl", content: "use strict;\nprint \"foo\";", expected: model.LanguagePerl},
+		{name: "ruby", content: "[1, 2].each do |i|\n  puts i\nend", expected: model.LanguageRuby},
+		{name: "shell with cmdlet like header", content: "curl -si https://example.com | grep Set-Cookie", expected: model.LanguageUnknown},
+		{name: "shell with cmdlet like argument", content: `echo "$(Get-Date)"`, expected: model.LanguageUnknown},
+		{name: "shell with cmdlet like label", content: "kubectl get pods -l app=Write-Ahead", expected: model.LanguageUnknown},
+		{name: "shell let", content: "let x = 1", expected: model.LanguageUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Detect(tt.content))
+		})
+	}
+}
+
+func Test_Resolve(t *testing.T) {
+	assert.Equal(t, model.LanguageYAML, Resolve(model.LanguageYAML, `{"foo": "bar"}`))
+	assert.Equal(t, model.LanguageJSON, Resolve(model.LanguageUnknown, `{"foo": "bar"}`))
+	assert.Equal(t, model.LanguageJSON, Resolve(model.LanguageText, `{"foo": "bar"}`))
+	assert.Equal(t, model.LanguageText, Resolve(model.LanguageText, "some notes"))
+	assert.Equal(t, model.LanguageUnknown, Resolve(model.LanguageUnknown, "echo foo"))
+}
+
+func Test_ShebangInterpreter(t *testing.T) {
+	tests := []struct {
+		script   string
+		expected string
+		ok       bool
+	}{
+		{script: "#!/bin/bash\necho foo", expected: "/bin/bash", ok: true},
+		{script: "#!/bin/bash -e\necho foo", expected: "/bin/bash", ok: true},
+		{script: "#!/usr/bin/env bash -e\necho foo", expected: "bash", ok: true},
+		{script: "#!/usr/bin/env   bash\necho foo", expected: "bash", ok: true},
+		{script: "#!  /bin/bash\n", expected: "/bin/bash", ok: true},
+		{script: "#!/bin/bash", ok: false},
+		{script: "#!\necho foo", ok: false},
+		{script: "echo foo", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.script, func(t *testing.T) {
+			interpreter, ok := ShebangInterpreter(tt.script)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, interpreter)
+		})
+	}
+}
+
+func Test_ShebangLanguage(t *testing.T) {
+	tests := []struct {
+		script   string
+		expected model.Language
+		ok       bool
+	}{
+		{script: "#!/usr/bin/env ruby\nputs 1", expected: model.LanguageRuby, ok: true},
+		{script: "#!/usr/bin/perl -w\nprint 1", expected: model.LanguagePerl, ok: true},
+		{script: "#!/usr/bin/python3.12\nprint(1)", expected: model.LanguagePython, ok: true},
+		{script: "#!/bin/zsh\necho foo", expected: model.LanguageBash, ok: true},
+		{script: "#!/usr/bin/env deno\nconsole.log(1)", expected: model.LanguageUnknown, ok: false},
+		{script: "puts 1", expected: model.LanguageUnknown, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.script, func(t *testing.T) {
+			language, ok := ShebangLanguage(tt.script)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, language)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/adrg/xdg"
 	"github.com/spf13/afero"
@@ -24,6 +26,7 @@ type System struct {
 	userConfigDirs *[]string
 	// userContainersDir is macOS only
 	userContainersDir *string
+	workingDir        *string
 }
 
 // Option configures a Provider.
@@ -73,6 +76,13 @@ func WithUserContainersDir(userContainersDir string) Option {
 	})
 }
 
+// WithWorkingDir sets the current working directory.
+func WithWorkingDir(workingDir string) Option {
+	return optionFunc(func(p *System) {
+		p.workingDir = &workingDir
+	})
+}
+
 // WithFS sets the file system.
 func WithFS(fs afero.Fs) Option {
 	return optionFunc(func(p *System) {
@@ -127,6 +137,17 @@ func (s *System) UserContainerPreferences(appID string) (string, error) {
 	return path.Join(containerDir, appID, "Data", "Library", "Preferences"), nil
 }
 
+// WorkingDir returns the current working directory. An empty string is returned if it cannot be determined.
+func (s *System) WorkingDir() string {
+	if s.workingDir != nil {
+		return *s.workingDir
+	}
+	if wd, err := os.Getwd(); err == nil {
+		return wd
+	}
+	return ""
+}
+
 func (s *System) ConfigPath() string {
 	return path.Join(s.HomeDir(), "config.yaml")
 }
@@ -161,6 +182,7 @@ func (s *System) RemoveAll(path string) {
 }
 
 func (s *System) FileExists(path string) bool {
+	path = expandPath(path)
 	exists, err := afero.Exists(s.Fs, path)
 	if err != nil {
 		panic(NewErrFileSystem(err, path, "failed to check if exists"))
@@ -199,6 +221,7 @@ func (s *System) CreatePath(path string) {
 }
 
 func (s *System) WriteFile(path string, data []byte) {
+	path = expandPath(path)
 	if err := afero.WriteFile(s.Fs, path, data, fileModeConfig); err != nil {
 		panic(ErrFileSystem{path: path, msg: "failed to write file", cause: err})
 	}
@@ -215,7 +238,7 @@ func (s *System) ReadFile(path string) []byte {
 }
 
 func expandPath(path string) string {
-	if path[:2] == "~/" {
+	if strings.HasPrefix(path, "~/") {
 		home, err := os.UserHomeDir()
 		if err != nil {
 			panic(err)
@@ -224,3 +247,13 @@ func expandPath(path string) string {
 	}
 	return path
 }
+
+// ModTime returns the modification time of the file. If the file cannot be accessed, the zero time is returned since
+// the modification time is informational only.
+func (s *System) ModTime(path string) time.Time {
+	info, err := s.Fs.Stat(expandPath(path))
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -6,6 +6,7 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/adrg/xdg"
 	"github.com/spf13/afero"
@@ -26,6 +27,7 @@ func Test_System_Default(t *testing.T) {
 	assert.NotEmpty(t, s.UserHome())
 	assert.NotEmpty(t, s.UserDataHome())
 	assert.NotEmpty(t, s.UserConfigDirs())
+	assert.NotEmpty(t, s.WorkingDir())
 
 	if v, err := s.UserContainerPreferences("test-app"); err != nil {
 		assert.NoError(t, err)
@@ -42,6 +44,7 @@ func Test_System_WithOptions(t *testing.T) {
 		WithUserDataDir("/test/user/data"),
 		WithUserHome("/test/user"),
 		WithUserContainersDir("/test/container/dir"),
+		WithWorkingDir("/test/project"),
 	)
 	assert.NotNil(t, s)
 
@@ -54,6 +57,7 @@ func Test_System_WithOptions(t *testing.T) {
 	assert.Equal(t, s.UserHome(), "/test/user")
 	assert.Equal(t, s.UserDataHome(), "/test/user/data")
 	assert.Equal(t, s.UserContainersHome(), "/test/container/dir")
+	assert.Equal(t, s.WorkingDir(), "/test/project")
 
 	if v, err := s.UserContainerPreferences("test-app"); err != nil {
 		assert.Nil(t, v)
@@ -131,6 +135,20 @@ func Test_DirExists(t *testing.T) {
 	assert.True(t, system.DirExists(filepath.Dir(path)))
 }
 
+func Test_ModTime(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	path := filepath.Join(t.TempDir(), "foo.txt")
+
+	system := NewSystem(WithFS(fs))
+	assert.True(t, system.ModTime(path).IsZero())
+
+	createTestFile(t, fs, path)
+	modTime := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, fs.Chtimes(path, modTime, modTime))
+
+	assert.True(t, modTime.Equal(system.ModTime(path)))
+}
+
 func Test_Remove(t *testing.T) {
 	fs := afero.NewMemMapFs()
 	path := filepath.Join(t.TempDir(), "foo.txt")
@@ -14,6 +14,7 @@ type TestSnippet struct {
 	Title    string
 	Content  string
 	Language model.Language
+	Metadata model.SnippetMetadata
 }
 
 var DummySnippet = TestSnippet{ID: "uuid-x", Title: "title-2", Language: model.LanguageBash, Tags: []string{}, Content: "testSnippetContent"}
@@ -46,6 +47,10 @@ func (t TestSnippet) Format(values []string, options model.SnippetFormatOptions)
 	return parser.CreateSnippet(t.Content, t.GetParameters(), values, options)
 }
 
+func (t TestSnippet) GetMetadata() model.SnippetMetadata {
+	return t.Metadata
+}
+
 func (t TestSnippet) String() string {
 	return fmt.Sprintf("Testsnippet: %s", t.Title)
 }